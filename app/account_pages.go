@@ -4,20 +4,85 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/adnaan/authn"
+	authnaccount "github.com/adnaan/authn/models/account"
 	"github.com/lithammer/shortuuid/v3"
 
-	rl "github.com/adnaan/renderlayout"
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+	"github.com/adnaan/gomodest-starter/app/gen/models/idempotencykey"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
 
-	"github.com/stripe/stripe-go/v72"
-	"github.com/stripe/stripe-go/v72/sub"
+	rl "github.com/adnaan/renderlayout"
 
 	"github.com/google/uuid"
 
 	"github.com/go-chi/chi"
 )
 
+const (
+	// keeps the from parameter across the logins which leave the site, see rememberLoginFrom
+	loginFromCookie = "login_from"
+	loginFromMaxAge = time.Hour
+)
+
+// relativeRedirect returns from if it's a path on this site and an empty string otherwise, so that a from parameter
+// can't redirect to another site.
+func relativeRedirect(from string) string {
+	if !strings.HasPrefix(from, "/") || strings.HasPrefix(from, "//") {
+		return ""
+	}
+	// browsers read a backslash as a slash and skip tabs and newlines
+	for _, c := range from {
+		if c == '\\' || c < 0x20 || c == 0x7f {
+			return ""
+		}
+	}
+	u, err := url.Parse(from)
+	if err != nil || u.Scheme != "" || u.Host != "" {
+		return ""
+	}
+	return from
+}
+
+// rememberLoginFrom stores the from parameter of a login which continues outside the site, by a magic link or a
+// provider, to return to it once the login completes.
+func rememberLoginFrom(w http.ResponseWriter, r *http.Request, appCtx Context) {
+	from := relativeRedirect(r.URL.Query().Get("from"))
+	if from == "" {
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     loginFromCookie,
+		Value:    url.QueryEscape(from),
+		Path:     "/",
+		MaxAge:   int(loginFromMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(appCtx.cfg.Domain, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// loginRedirect returns where to go after a login: the from parameter, else the one stored by rememberLoginFrom, else
+// the app. The stored one is cleared.
+func loginRedirect(w http.ResponseWriter, r *http.Request) string {
+	from := relativeRedirect(r.URL.Query().Get("from"))
+	if cookie, err := r.Cookie(loginFromCookie); err == nil {
+		http.SetCookie(w, &http.Cookie{Name: loginFromCookie, Path: "/", MaxAge: -1})
+		if stored, err := url.QueryUnescape(cookie.Value); err == nil && from == "" {
+			from = relativeRedirect(stored)
+		}
+	}
+	if from == "" {
+		return "/app"
+	}
+	return from
+}
+
 func defaultPageHandler(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
 		pageData := map[string]interface{}{}
@@ -31,7 +96,11 @@ func defaultPageHandler(appCtx Context) rl.Data {
 		}
 
 		accAttributes := account.Attributes().Map()
-		if _, ok := accAttributes["api_key"]; ok {
+		hasAPIToken, err := appCtx.db.APIToken.Query().Where(apitoken.Owner(account.ID().String())).Exist(r.Context())
+		if err != nil {
+			log.Println("hasAPIToken", err)
+		}
+		if hasAPIToken {
 			pageData["is_api_token_set"] = true
 		}
 
@@ -39,48 +108,36 @@ func defaultPageHandler(appCtx Context) rl.Data {
 		pageData["email"] = account.Email()
 		pageData["metadata"] = accAttributes
 
-		currentPriceID, _ := account.Attributes().Session().Get(currentPriceIDKey)
-		// get currentPriceID using stripe customer ID
-		billingId, billingIDExists := accAttributes.String(billingIDKey)
-		if billingIDExists && currentPriceID == nil {
-			params := &stripe.SubscriptionListParams{
-				Customer: billingId,
-				Status:   string(stripe.SubscriptionStatusActive),
-			}
-			params.AddExpand("data.items.data.price")
-			params.Filters.AddFilter("limit", "", "1")
-
-			i := sub.List(params)
-			for i.Next() {
-				s := i.Subscription()
-				if s.Status == stripe.SubscriptionStatusActive {
-					for _, pr := range s.Items.Data {
-						currentPriceID = pr.Price.ID
-					}
-				}
-
-			}
+		workspaceID, err := currentWorkspaceID(r, appCtx, account.ID().String())
+		if err != nil {
+			log.Println("currentWorkspaceID", err)
 		}
+		workspaces, err := accountWorkspaces(r.Context(), appCtx, account.ID().String(), workspaceID)
+		if err != nil {
+			log.Println("accountWorkspaces", err)
+		}
+		pageData["workspaces"] = workspaces
 
-		if currentPriceID != nil {
-			err = account.Attributes().Session().Set(w, currentPriceIDKey, currentPriceID)
-			if err != nil {
-				log.Println("SetSessionVal", err)
-			}
+		billingID, _ := accAttributes.String(billingIDKey)
+		priceID, err := currentPriceID(r.Context(), appCtx, account.ID().String(), billingID)
+		if err != nil {
+			log.Println("currentPriceID", err)
+		}
 
+		if priceID != "" {
 			for _, plan := range appCtx.cfg.Plans {
-				if plan.PriceID == currentPriceID.(string) {
+				if plan.PriceID == priceID {
 					pageData["current_plan"] = Plan{
 						Current:   true,
 						PriceID:   plan.PriceID,
 						Name:      plan.Name,
 						Price:     plan.Price,
 						Details:   plan.Details,
+						Features:  plan.Features,
 						StripeKey: plan.StripeKey,
 					}
 				}
 			}
-
 		}
 
 		return pageData, nil
@@ -89,10 +146,12 @@ func defaultPageHandler(appCtx Context) rl.Data {
 
 func signupPage(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
-		var email, password string
+		var email, password, invite string
 		metadata := make(map[string]interface{})
 		_ = r.ParseForm()
 		for k, v := range r.Form {
+			// the form fields are capitalized like the other forms
+			k = strings.ToLower(k)
 
 			if k == "email" && len(v) == 0 {
 				return rl.D{}, fmt.Errorf("email is required")
@@ -116,6 +175,12 @@ func signupPage(appCtx Context) rl.Data {
 				continue
 			}
 
+			// the token of the invitation which led to the signup
+			if k == "invite" {
+				invite = v[0]
+				continue
+			}
+
 			if len(v) == 1 {
 				metadata[k] = v[0]
 				continue
@@ -130,7 +195,12 @@ func signupPage(appCtx Context) rl.Data {
 			return rl.D{}, err
 		}
 
-		http.Redirect(w, r, "/login?confirmation_sent=true", http.StatusSeeOther)
+		redirectTo := "/login?confirmation_sent=true"
+		if invite != "" {
+			redirectTo += "&from=" + url.QueryEscape("/invite/"+invite)
+		}
+
+		http.Redirect(w, r, redirectTo, http.StatusSeeOther)
 
 		return rl.D{}, nil
 	}
@@ -138,36 +208,21 @@ func signupPage(appCtx Context) rl.Data {
 
 func loginPage(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
-
-		confirmed := r.URL.Query().Get("confirmed")
-		if confirmed == "true" {
-			return rl.D{
-				"confirmed": true,
-			}, nil
-		}
-
-		notConfirmed := r.URL.Query().Get("not_confirmed")
-		if notConfirmed == "true" {
-			return rl.D{
-				"not_confirmed": true,
-			}, nil
+		// the login forms post to the page url, so they keep the from parameter, the provider login gets it here
+		providerLogin := "/auth?provider=google"
+		if from := relativeRedirect(r.URL.Query().Get("from")); from != "" {
+			providerLogin += "&from=" + url.QueryEscape(from)
 		}
+		data := rl.D{"provider_login": providerLogin}
 
-		confirmationSent := r.URL.Query().Get("confirmation_sent")
-		if confirmationSent == "true" {
-			return rl.D{
-				"confirmation_sent": true,
-			}, nil
-		}
-
-		emailChanged := r.URL.Query().Get("email_changed")
-		if emailChanged == "true" {
-			return rl.D{
-				"email_changed": true,
-			}, nil
+		for _, flag := range []string{"confirmed", "not_confirmed", "confirmation_sent", "email_changed"} {
+			if r.URL.Query().Get(flag) == "true" {
+				data[flag] = true
+				break
+			}
 		}
 
-		return rl.D{}, nil
+		return data, nil
 	}
 }
 
@@ -199,23 +254,24 @@ func loginPageSubmit(appCtx Context) rl.Data {
 			if err != nil {
 				return nil, err
 			}
+			rememberLoginFrom(w, r, appCtx)
+			accountID := accountIDByEmail(r.Context(), appCtx, *form.Email)
+			recordAuditEvent(r, appCtx, accountID, "", auditMagicLinkSent, nil)
 			http.Redirect(w, r, "/magic-link-sent", http.StatusSeeOther)
 		} else {
 			if form.Password == nil {
 				return nil, fmt.Errorf("%w", fmt.Errorf("password is empty"))
 			}
+			accountID := accountIDByEmail(r.Context(), appCtx, *form.Email)
 			err := appCtx.authn.Login(w, r, *form.Email, *form.Password)
 			if err != nil {
+				recordAuditEvent(r, appCtx, accountID, "", auditLoginFailed,
+					auditDiff{"method": "password", "email": *form.Email})
 				return nil, err
 			}
+			recordAuditEvent(r, appCtx, accountID, accountID, auditLoginSucceeded, auditDiff{"method": "password"})
 
-			redirectTo := "/app"
-			from := r.URL.Query().Get("from")
-			if from != "" {
-				redirectTo = from
-			}
-
-			http.Redirect(w, r, redirectTo, http.StatusSeeOther)
+			http.Redirect(w, r, loginRedirect(w, r), http.StatusSeeOther)
 		}
 
 		return rl.D{}, nil
@@ -225,14 +281,19 @@ func loginPageSubmit(appCtx Context) rl.Data {
 func magicLinkLoginConfirm(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
 		otp := chi.URLParam(r, "otp")
+		// the token is cleared by the login, so the account is looked up before
+		accountID := ""
+		if acc, err := appCtx.accounts.Account.Query().Where(authnaccount.Otp(otp)).Only(r.Context()); err == nil {
+			accountID = acc.ID.String()
+		}
 		err := appCtx.authn.LoginWithPasswordlessToken(w, r, otp)
 		if err != nil {
+			recordAuditEvent(r, appCtx, accountID, "", auditLoginFailed, auditDiff{"method": "magic_link"})
 			return nil, err
 		}
+		recordAuditEvent(r, appCtx, accountID, accountID, auditLoginSucceeded, auditDiff{"method": "magic_link"})
 
-		redirectTo := "/app"
-
-		http.Redirect(w, r, redirectTo, http.StatusSeeOther)
+		http.Redirect(w, r, loginRedirect(w, r), http.StatusSeeOther)
 
 		return rl.D{}, nil
 	}
@@ -240,30 +301,34 @@ func magicLinkLoginConfirm(appCtx Context) rl.Data {
 
 func loginProviderCallbackPage(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		provider := r.URL.Query().Get("provider")
 		err := appCtx.authn.LoginProviderCallback(w, r, nil)
 		if err != nil {
+			recordAuditEvent(r, appCtx, "", "", auditLoginFailed, auditDiff{"method": provider})
 			return rl.D{}, err
 		}
-		redirectTo := "/app"
+		// the session of the request is updated by the login
+		if acc, err := appCtx.authn.CurrentAccount(r); err == nil {
+			accountID := acc.ID().String()
+			recordAuditEvent(r, appCtx, accountID, accountID, auditLoginSucceeded, auditDiff{"method": provider})
+		}
 
-		http.Redirect(w, r, redirectTo, http.StatusSeeOther)
+		http.Redirect(w, r, loginRedirect(w, r), http.StatusSeeOther)
 		return rl.D{}, nil
 	}
 }
 
 func loginProviderPage(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		// set before the provider's redirect is written
+		rememberLoginFrom(w, r, appCtx)
 		err := appCtx.authn.LoginWithProvider(w, r)
 		if err != nil {
+			recordAuditEvent(r, appCtx, "", "", auditLoginFailed, auditDiff{"method": r.URL.Query().Get("provider")})
 			return rl.D{}, err
 		}
-		redirectTo := "/app"
-		from := r.URL.Query().Get("from")
-		if from != "" {
-			redirectTo = from
-		}
 
-		http.Redirect(w, r, redirectTo, http.StatusSeeOther)
+		http.Redirect(w, r, loginRedirect(w, r), http.StatusSeeOther)
 		return rl.D{}, nil
 	}
 }
@@ -275,10 +340,17 @@ func confirmEmailChangePage(appCtx Context) rl.Data {
 		if err != nil {
 			return nil, err
 		}
+		previousEmail := acc.Email()
 		err = acc.ConfirmEmailChange(token)
 		if err != nil {
 			return nil, err
 		}
+		// the account isn't reloaded by the confirmation
+		accountID := acc.ID().String()
+		if email, err := accountEmail(r.Context(), appCtx, accountID); err == nil {
+			recordAuditEvent(r, appCtx, accountID, accountID, auditEmailChanged,
+				auditDiff{"email": auditChange(previousEmail, email)})
+		}
 		http.Redirect(w, r, "/account?email_changed=true", http.StatusSeeOther)
 		return rl.D{}, nil
 	}
@@ -372,17 +444,62 @@ func accountPage(appCtx Context) rl.Data {
 			}, nil
 		}
 
+		userID := authn.AccountIDFromContext(r)
+		workspaceID := workspaceIDFromContext(r)
+		featureUsages, err := accountUsage(r.Context(), appCtx, currentPlan(appCtx, r), workspaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		// the endpoints are only listed to the members who can manage them
+		canManageWebhooks, err := hasPermission(r.Context(), appCtx, userID, workspaceID, permWebhooksManage)
+		if err != nil {
+			return nil, err
+		}
+		var endpoints []*models.WebhookEndpoint
+		var deliveries []webhookDeliveryLog
+		if canManageWebhooks {
+			endpoints, deliveries, err = webhookEndpoints(r.Context(), appCtx, workspaceID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		members, err := workspaceMembers(r.Context(), appCtx, workspaceID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		auditEvents, err := accountAuditEvents(r.Context(), appCtx, userID)
+		if err != nil {
+			return nil, err
+		}
+
 		checkout := r.URL.Query().Get("checkout")
 		if checkout == "success" || checkout == "cancel" {
 			return rl.D{
-				"checkout": checkout,
-				"plans":    appCtx.cfg.Plans,
+				"checkout":            checkout,
+				"plans":               appCtx.cfg.Plans,
+				"usage":               featureUsages,
+				"usage_period":        usagePeriod(time.Now()),
+				"can_manage_webhooks": canManageWebhooks,
+				"webhook_endpoints":   endpoints,
+				"webhook_deliveries":  deliveries,
+				"members_props":       members,
+				"audit_events":        auditEvents,
 			}, nil
 		}
 
 		return rl.D{
-			"form_token": uuid.New(),
-			"plans":      appCtx.cfg.Plans,
+			"form_token":          uuid.New(),
+			"plans":               appCtx.cfg.Plans,
+			"usage":               featureUsages,
+			"usage_period":        usagePeriod(time.Now()),
+			"can_manage_webhooks": canManageWebhooks,
+			"webhook_endpoints":   endpoints,
+			"webhook_deliveries":  deliveries,
+			"members_props":       members,
+			"audit_events":        auditEvents,
 		}, nil
 	}
 }
@@ -428,15 +545,16 @@ func accountPageSubmit(appCtx Context) rl.Data {
 			apiKey := shortuuid.New()
 			token, err := appCtx.branca.EncodeToString(apiKey)
 			if err != nil {
-				return nil, fmt.Errorf("%v %w", err, ErrInternal)
+				return nil, fmt.Errorf("%v %w", err, authn.ErrInternal)
 			}
 
-			err = account.Attributes().Set("api_key", apiKey)
+			err = setAPIKey(r.Context(), appCtx, account.ID().String(), apiKey)
 			if err != nil {
-				return nil, fmt.Errorf("%v %w", err, ErrInternal)
+				return nil, fmt.Errorf("%v %w", err, authn.ErrInternal)
 			}
 
 			account.Attributes().Session().Set(w, "form_token", form.FormToken)
+			recordAuditEvent(r, appCtx, account.ID().String(), account.ID().String(), auditAPITokenRegenerated, nil)
 			return rl.D{
 				"is_api_token_set": true,
 				"api_token":        token,
@@ -448,6 +566,8 @@ func accountPageSubmit(appCtx Context) rl.Data {
 			if err != nil {
 				return nil, err
 			}
+			recordAuditEvent(r, appCtx, account.ID().String(), account.ID().String(), auditEmailChangeRequested,
+				auditDiff{"email": auditChange(account.Email(), *form.Email)})
 			pageData["change_email"] = "requested"
 		}
 
@@ -457,6 +577,8 @@ func accountPageSubmit(appCtx Context) rl.Data {
 			if err != nil {
 				return nil, err
 			}
+			recordAuditEvent(r, appCtx, account.ID().String(), account.ID().String(), auditAccountUpdated,
+				auditDiff{"name": auditChange(name, *form.Name)})
 		}
 
 		account.Attributes().Session().Set(w, "form_token", form.FormToken)
@@ -473,10 +595,50 @@ func deleteAccount(appCtx Context) rl.Data {
 		if err != nil {
 			return nil, err
 		}
+		accountID := account.ID().String()
+
+		// deleting the account is refused rather than leaving a shared workspace without an owner
+		unshared, shared, err := ownedWorkspaces(r.Context(), appCtx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		if len(shared) > 0 {
+			names := make([]string, 0, len(shared))
+			for _, ws := range shared {
+				names = append(names, ws.Name)
+			}
+			return formErrors(fieldErrors{"account": fmt.Sprintf(
+				"is the only owner of the shared workspaces %s, their members would be left without an owner", strings.Join(names, ", "))})
+		}
+
+		// the workspaces without other members, including the personal one, are deleted with their data
+		err = withTx(r.Context(), appCtx, func(txCtx Context) error {
+			for _, ws := range unshared {
+				if err := deleteWorkspace(r.Context(), txCtx, ws.ID); err != nil {
+					return err
+				}
+			}
+			_, err := txCtx.db.Membership.Delete().Where(membership.AccountID(accountID)).Exec(r.Context())
+			if err != nil {
+				return err
+			}
+			_, err = txCtx.db.IdempotencyKey.Delete().Where(idempotencykey.Owner(accountID)).Exec(r.Context())
+			if err != nil {
+				return err
+			}
+			_, err = txCtx.db.APIToken.Delete().Where(apitoken.Owner(accountID)).Exec(r.Context())
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
 		err = account.Delete()
 		if err != nil {
 			return nil, err
 		}
+		// the audit events of the account are kept
+		recordAuditEvent(r, appCtx, account.ID().String(), account.ID().String(), auditAccountDeleted,
+			auditDiff{"email": auditChange(account.Email(), nil)})
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 		return rl.D{}, nil
 	}
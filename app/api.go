@@ -1,126 +1,253 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"net/http"
-	"time"
 
 	"github.com/go-chi/chi"
 
-	"github.com/lithammer/shortuuid/v3"
-
 	"github.com/go-chi/render"
 
-	"github.com/adnaan/authn"
+	"github.com/adnaan/gomodest-starter/app/gen/models"
 	"github.com/adnaan/gomodest-starter/app/gen/models/task"
 )
 
 func list(t Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		userID := authn.AccountIDFromContext(r)
-		tasks, err := t.db.Task.Query().Where(task.Owner(userID)).All(r.Context())
+		params, err := parseTaskListParams(r.URL.Query())
+		if err != nil {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+
+		workspaceID := workspaceIDFromContext(r)
+		tasks, err := params.apply(t.db.Task.Query().Where(task.Owner(workspaceID))).WithTags().WithGroup().All(r.Context())
 		if err != nil {
 			render.Render(w, r, ErrInternal(err))
 			return
 		}
 
-		render.JSON(w, r, tasks)
+		tasks, nextCursor := params.page(tasks)
+		render.Render(w, r, newTaskListResponse(tasks, nextCursor))
 	}
 }
 
-func create(t Context) http.HandlerFunc {
-	type req struct {
-		Text string `json:"text"`
+func get(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), t, workspaceID, id)
+		if err != nil {
+			renderTaskErr(w, r, err)
+			return
+		}
+		renderTask(w, r, ownedTask)
 	}
+}
+
+func create(t Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		req := new(req)
-		userID := authn.AccountIDFromContext(r)
-		err := render.DecodeJSON(r.Body, &req)
+		req := new(createTaskRequest)
+		workspaceID := workspaceIDFromContext(r)
+		err := render.Bind(r, req)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderBindErr(w, r, err)
 			return
 		}
 
-		newTask, err := t.db.Task.Create().
-			SetID(shortuuid.New()).
-			SetStatus(task.StatusInprogress).
-			SetOwner(userID).
-			SetText(req.Text).
-			Save(r.Context())
+		err = checkTaskGroup(r.Context(), t, workspaceID, req.GroupID)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderTaskGroupErr(w, r, err)
 			return
 		}
-		render.JSON(w, r, newTask)
+
+		createdTask, err := newTaskWithinLimit(r.Context(), t, workspaceID, req.Text, req.taskFieldsRequest)
+		if err != nil {
+			renderLimitErr(w, r, err)
+			return
+		}
+		emitTaskEvent(r.Context(), t, workspaceID, taskCreatedEvent, newTaskResponse(createdTask))
+		renderTask(w, r, createdTask)
 	}
 }
 
 func updateStatus(t Context) http.HandlerFunc {
-	type req struct {
-		Status string `json:"status"`
-	}
 	return func(w http.ResponseWriter, r *http.Request) {
-		req := new(req)
+		req := new(updateTaskStatusRequest)
 		id := chi.URLParam(r, "id")
 
-		err := render.DecodeJSON(r.Body, &req)
+		err := render.Bind(r, req)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderBindErr(w, r, err)
 			return
 		}
 
-		updatedTask, err := t.db.Task.
-			UpdateOneID(id).
-			SetUpdatedAt(time.Now()).
-			SetStatus(task.Status(req.Status)).
-			Save(r.Context())
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), t, workspaceID, id)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderTaskErr(w, r, err)
+			return
+		}
+
+		if !ifMatch(r.Header.Get("If-Match"), ownedTask) {
+			renderTaskErr(w, r, errTaskChanged)
 			return
 		}
-		render.JSON(w, r, updatedTask)
+
+		updatedTask, err := saveTask(r.Context(), t, ownedTask, func(update *models.TaskUpdate) {
+			update.SetStatus(task.Status(req.Status))
+		})
+		if err != nil {
+			renderTaskErr(w, r, err)
+			return
+		}
+		emitTaskEvent(r.Context(), t, workspaceID, taskUpdatedEvent, newTaskResponse(updatedTask))
+		renderTask(w, r, updatedTask)
 	}
 }
 
 func updateText(t Context) http.HandlerFunc {
-	type req struct {
-		Text string `json:"text"`
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(updateTaskTextRequest)
+		id := chi.URLParam(r, "id")
+
+		err := render.Bind(r, req)
+		if err != nil {
+			renderBindErr(w, r, err)
+			return
+		}
+
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), t, workspaceID, id)
+		if err != nil {
+			renderTaskErr(w, r, err)
+			return
+		}
+
+		if !ifMatch(r.Header.Get("If-Match"), ownedTask) {
+			renderTaskErr(w, r, errTaskChanged)
+			return
+		}
+
+		updatedTask, err := saveTask(r.Context(), t, ownedTask, func(update *models.TaskUpdate) {
+			update.SetText(req.Text)
+		})
+		if err != nil {
+			renderTaskErr(w, r, err)
+			return
+		}
+		emitTaskEvent(r.Context(), t, workspaceID, taskUpdatedEvent, newTaskResponse(updatedTask))
+		renderTask(w, r, updatedTask)
 	}
+}
+
+// patch updates only the fields present in the request
+func patch(t Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		req := new(req)
+		req := new(patchTaskRequest)
 		id := chi.URLParam(r, "id")
 
-		err := render.DecodeJSON(r.Body, &req)
+		err := render.Bind(r, req)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderBindErr(w, r, err)
 			return
 		}
 
-		updatedTask, err := t.db.Task.
-			UpdateOneID(id).
-			SetUpdatedAt(time.Now()).
-			SetText(req.Text).
-			Save(r.Context())
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), t, workspaceID, id)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderTaskErr(w, r, err)
+			return
+		}
+
+		if !ifMatch(r.Header.Get("If-Match"), ownedTask) {
+			renderTaskErr(w, r, errTaskChanged)
+			return
+		}
+
+		err = checkTaskGroup(r.Context(), t, workspaceID, req.GroupID)
+		if err != nil {
+			renderTaskGroupErr(w, r, err)
+			return
+		}
+
+		updatedTask, err := saveTaskWithTags(r.Context(), t, ownedTask, req.Tags, func(update *models.TaskUpdate, tagIDs []string) {
+			if req.Text != nil {
+				update.SetText(*req.Text)
+			}
+			if req.Status != nil {
+				update.SetStatus(task.Status(*req.Status))
+			}
+			req.taskFieldsRequest.apply(update.Mutation(), tagIDs)
+		})
+		if err != nil {
+			renderTaskErr(w, r, err)
 			return
 		}
-		render.JSON(w, r, updatedTask)
+		emitTaskEvent(r.Context(), t, workspaceID, taskUpdatedEvent, newTaskResponse(updatedTask))
+		renderTask(w, r, updatedTask)
 	}
 }
 
 func delete(t Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
-		err := t.db.Task.DeleteOneID(id).Exec(r.Context())
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), t, workspaceID, id)
 		if err != nil {
-			render.Render(w, r, ErrInternal(err))
+			renderTaskErr(w, r, err)
+			return
+		}
+
+		if !ifMatch(r.Header.Get("If-Match"), ownedTask) {
+			renderTaskErr(w, r, errTaskChanged)
 			return
 		}
+
+		err = removeTask(r.Context(), t, ownedTask)
+		if err != nil {
+			renderTaskErr(w, r, err)
+			return
+		}
+		emitTaskEvent(r.Context(), t, workspaceID, taskDeletedEvent, map[string]string{"id": id})
 		render.Status(r, http.StatusOK)
-		render.JSON(w, r, struct {
-			Success bool `json:"success"`
-		}{
-			Success: true,
-		})
+		render.Render(w, r, &deleteTaskResponse{Success: true})
+	}
+}
+
+// findOwnedTask looks up a task by id scoped to its workspace. A task of another workspace is reported as not found.
+func findOwnedTask(ctx context.Context, t Context, workspaceID, id string) (*models.Task, error) {
+	return t.db.Task.Query().Where(task.And(
+		task.Owner(workspaceID), task.ID(id),
+	)).WithTags().WithGroup().Only(ctx)
+}
+
+func renderTaskErr(w http.ResponseWriter, r *http.Request, err error) {
+	if models.IsNotFound(err) {
+		render.Render(w, r, ErrNotFound)
+		return
+	}
+	if errors.Is(err, errTaskChanged) {
+		render.Render(w, r, ErrPreconditionFailed(err))
+		return
+	}
+	render.Render(w, r, ErrInternal(err))
+}
+
+func renderTaskGroupErr(w http.ResponseWriter, r *http.Request, err error) {
+	if _, ok := err.(fieldErrors); ok {
+		render.Render(w, r, ErrValidation(err))
+		return
+	}
+	render.Render(w, r, ErrInternal(err))
+}
+
+func renderLimitErr(w http.ResponseWriter, r *http.Request, err error) {
+	var limitErr *limitError
+	if errors.As(err, &limitErr) {
+		render.Render(w, r, ErrLimitExceeded(err))
+		return
 	}
+	render.Render(w, r, ErrInternal(err))
 }
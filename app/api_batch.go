@@ -0,0 +1,267 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+const maxBatchOperations = 500
+
+// batch modes
+const (
+	// atomicBatchMode applies either all the operations or none of them
+	atomicBatchMode = "atomic"
+	// bestEffortBatchMode applies the valid operations and skips the failed ones
+	bestEffortBatchMode = "best_effort"
+)
+
+// batch operations
+const (
+	createBatchOp = "create"
+	updateBatchOp = "update"
+	deleteBatchOp = "delete"
+)
+
+type batchTasksRequest struct {
+	Mode       string               `json:"mode,omitempty"`
+	Operations []batchTaskOperation `json:"operations"`
+}
+
+func (req *batchTasksRequest) Bind(r *http.Request) error {
+	if req.Mode == "" {
+		req.Mode = atomicBatchMode
+	}
+	v := new(validator)
+	v.check(req.Mode == atomicBatchMode || req.Mode == bestEffortBatchMode, "mode",
+		fmt.Sprintf("must be one of %s, %s", atomicBatchMode, bestEffortBatchMode))
+	v.check(len(req.Operations) > 0, "operations", "is required")
+	v.check(len(req.Operations) <= maxBatchOperations, "operations",
+		fmt.Sprintf("must have at most %d items", maxBatchOperations))
+	return v.err()
+}
+
+type batchTaskOperation struct {
+	Op     string  `json:"op"`
+	ID     string  `json:"id,omitempty"`
+	Text   *string `json:"text,omitempty"`
+	Status *string `json:"status,omitempty"`
+	// Version is the If-Match of an update or delete, zero matches any version
+	Version int `json:"version,omitempty"`
+	taskFieldsRequest
+}
+
+func (op batchTaskOperation) validate() error {
+	v := new(validator)
+	switch op.Op {
+	case createBatchOp:
+		text := ""
+		if op.Text != nil {
+			text = *op.Text
+		}
+		v.taskText("text", text)
+	case updateBatchOp:
+		v.required("id", op.ID)
+		v.check(op.Text != nil || op.Status != nil || !op.taskFieldsRequest.empty(), "text",
+			"or another field is required")
+	case deleteBatchOp:
+		v.required("id", op.ID)
+	default:
+		v.check(false, "op", fmt.Sprintf("must be one of %s, %s, %s", createBatchOp, updateBatchOp, deleteBatchOp))
+	}
+	if op.Op != createBatchOp && op.Text != nil {
+		v.taskText("text", *op.Text)
+	}
+	if op.Status != nil {
+		v.taskStatus("status", *op.Status)
+	}
+	op.taskFieldsRequest.validate(v)
+	return v.err()
+}
+
+// batchTaskResult is the outcome of an operation. Status is the http status code the operation would have
+// received on its own endpoint.
+type batchTaskResult struct {
+	Op     string            `json:"op"`
+	Status int               `json:"status"`
+	Task   *taskResponse     `json:"task,omitempty"`
+	Error  string            `json:"error,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+type batchTasksResponse struct {
+	Mode string `json:"mode"`
+	// Applied is false if an atomic batch was rolled back
+	Applied bool              `json:"applied"`
+	Results []batchTaskResult `json:"results"`
+}
+
+func (res *batchTasksResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// batch runs the operations in a single transaction. In the atomic mode a failed operation rolls back the whole
+// batch and the operations after it aren't run, in the best effort mode it's only reported in its result. Database
+// errors always roll back the batch.
+func batch(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(batchTasksRequest)
+		err := render.Bind(r, req)
+		if err != nil {
+			renderBindErr(w, r, err)
+			return
+		}
+
+		workspaceID := workspaceIDFromContext(r)
+		tx, err := t.db.Tx(r.Context())
+		if err != nil {
+			render.Render(w, r, ErrInternal(err))
+			return
+		}
+
+		// the operations, including the plan limit checks, see the changes of the previous ones
+		txCtx := t
+		txCtx.db = tx.Client()
+		txCtx.inTx = true
+
+		res := &batchTasksResponse{Mode: req.Mode, Applied: true}
+		var events []batchTaskEvent
+		for _, op := range req.Operations {
+			result, event, err := applyBatchOperation(r.Context(), txCtx, r, workspaceID, op)
+			if err != nil {
+				_ = tx.Rollback()
+				render.Render(w, r, ErrInternal(err))
+				return
+			}
+			if event != nil {
+				events = append(events, *event)
+			}
+			res.Results = append(res.Results, result)
+			if result.Error != "" && req.Mode == atomicBatchMode {
+				res.Applied = false
+				break
+			}
+		}
+
+		if !res.Applied {
+			if err := tx.Rollback(); err != nil {
+				render.Render(w, r, ErrInternal(err))
+				return
+			}
+			for i := range res.Results {
+				if res.Results[i].Error == "" {
+					res.Results[i].Status = http.StatusFailedDependency
+					res.Results[i].Error = "rolled back"
+					res.Results[i].Task = nil
+				}
+			}
+			for _, op := range req.Operations[len(res.Results):] {
+				res.Results = append(res.Results, batchTaskResult{
+					Op:     op.Op,
+					Status: http.StatusFailedDependency,
+					Error:  "not run, a previous operation failed",
+				})
+			}
+			render.Status(r, http.StatusUnprocessableEntity)
+			render.Render(w, r, res)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
+			render.Render(w, r, ErrInternal(err))
+			return
+		}
+		for _, event := range events {
+			emitTaskEvent(r.Context(), t, workspaceID, event.eventType, event.data)
+		}
+		render.Render(w, r, res)
+	}
+}
+
+type batchTaskEvent struct {
+	eventType string
+	data      interface{}
+}
+
+// applyBatchOperation returns an error only if the transaction can't continue. Failures of the operation itself are
+// reported in the result.
+func applyBatchOperation(ctx context.Context, t Context, r *http.Request, workspaceID string, op batchTaskOperation) (batchTaskResult, *batchTaskEvent, error) {
+	result := batchTaskResult{Op: op.Op}
+	if err := op.validate(); err != nil {
+		result.Status = http.StatusUnprocessableEntity
+		result.Error = err.Error()
+		result.Fields = err.(fieldErrors)
+		return result, nil, nil
+	}
+
+	if err := checkTaskGroup(ctx, t, workspaceID, op.GroupID); err != nil {
+		if fe, ok := err.(fieldErrors); ok {
+			result.Status = http.StatusUnprocessableEntity
+			result.Error = fe.Error()
+			result.Fields = fe
+			return result, nil, nil
+		}
+		return result, nil, err
+	}
+
+	if op.Op == createBatchOp {
+		createdTask, err := newTaskWithinLimit(ctx, t, workspaceID, *op.Text, op.taskFieldsRequest)
+		if err != nil {
+			var limitErr *limitError
+			if errors.As(err, &limitErr) {
+				result.Status = http.StatusForbidden
+				result.Error = err.Error()
+				return result, nil, nil
+			}
+			return result, nil, err
+		}
+		result.Status = http.StatusCreated
+		result.Task = newTaskResponse(createdTask)
+		return result, &batchTaskEvent{eventType: taskCreatedEvent, data: result.Task}, nil
+	}
+
+	ownedTask, err := findOwnedTask(ctx, t, workspaceID, op.ID)
+	if err != nil {
+		if models.IsNotFound(err) {
+			result.Status = http.StatusNotFound
+			result.Error = fmt.Sprintf("task %s not found", op.ID)
+			return result, nil, nil
+		}
+		return result, nil, err
+	}
+	if !versionMatches(op.Version, ownedTask) {
+		result.Status = http.StatusPreconditionFailed
+		result.Error = errTaskChanged.Error()
+		return result, nil, nil
+	}
+
+	if op.Op == deleteBatchOp {
+		if err := removeTask(ctx, t, ownedTask); err != nil {
+			return result, nil, err
+		}
+		result.Status = http.StatusOK
+		return result, &batchTaskEvent{eventType: taskDeletedEvent, data: map[string]string{"id": op.ID}}, nil
+	}
+
+	updatedTask, err := saveTaskWithTags(ctx, t, ownedTask, op.Tags, func(update *models.TaskUpdate, tagIDs []string) {
+		if op.Text != nil {
+			update.SetText(*op.Text)
+		}
+		if op.Status != nil {
+			update.SetStatus(task.Status(*op.Status))
+		}
+		op.taskFieldsRequest.apply(update.Mutation(), tagIDs)
+	})
+	if err != nil {
+		return result, nil, err
+	}
+	result.Status = http.StatusOK
+	result.Task = newTaskResponse(updatedTask)
+	return result, &batchTaskEvent{eventType: taskUpdatedEvent, data: result.Task}, nil
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+func TestBatchAtomicRollback(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	accountID, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	existing := new(taskResponse)
+	apiRequest(t, api, token, http.MethodPost, "/tasks", map[string]string{"text": "existing"}, existing)
+
+	created, changed := "created", "changed"
+	res := new(batchTasksResponse)
+	w := apiRequest(t, api, token, http.MethodPost, "/tasks/batch", batchTasksRequest{
+		Mode: atomicBatchMode,
+		Operations: []batchTaskOperation{
+			{Op: createBatchOp, Text: &created},
+			{Op: updateBatchOp, ID: existing.ID, Text: &changed},
+			{Op: deleteBatchOp, ID: "missing"},
+			{Op: createBatchOp, Text: &created},
+		},
+	}, res)
+	if w.Code != http.StatusUnprocessableEntity || res.Applied {
+		t.Fatalf("got %d and applied %v, want the batch to be rolled back: %s", w.Code, res.Applied, w.Body.String())
+	}
+	for i, want := range []int{http.StatusFailedDependency, http.StatusFailedDependency, http.StatusNotFound, http.StatusFailedDependency} {
+		if res.Results[i].Status != want || res.Results[i].Task != nil {
+			t.Errorf("operation %d: got %d, want %d", i, res.Results[i].Status, want)
+		}
+	}
+
+	tasks, err := appCtx.db.Task.Query().Where(task.Owner(accountID)).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].Text != "existing" {
+		t.Fatalf("got %d tasks, want only the unchanged existing task", len(tasks))
+	}
+}
+
+func TestBatchBestEffortPartialSuccess(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	accountID, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	existing := new(taskResponse)
+	apiRequest(t, api, token, http.MethodPost, "/tasks", map[string]string{"text": "existing"}, existing)
+
+	created, empty := "created", ""
+	res := new(batchTasksResponse)
+	w := apiRequest(t, api, token, http.MethodPost, "/tasks/batch", batchTasksRequest{
+		Mode: bestEffortBatchMode,
+		Operations: []batchTaskOperation{
+			{Op: createBatchOp, Text: &created},
+			{Op: createBatchOp, Text: &empty},
+			{Op: deleteBatchOp, ID: existing.ID},
+		},
+	}, res)
+	if w.Code != http.StatusOK || !res.Applied {
+		t.Fatalf("got %d and applied %v: %s", w.Code, res.Applied, w.Body.String())
+	}
+	for i, want := range []int{http.StatusCreated, http.StatusUnprocessableEntity, http.StatusOK} {
+		if res.Results[i].Status != want {
+			t.Errorf("operation %d: got %d, want %d", i, res.Results[i].Status, want)
+		}
+	}
+
+	tasks, err := appCtx.db.Task.Query().Where(task.Owner(accountID)).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].ID != res.Results[0].Task.ID {
+		t.Fatalf("got %d tasks, want only the created task", len(tasks))
+	}
+}
@@ -0,0 +1,307 @@
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+	defaultTaskSort = "created_at"
+)
+
+// sortable task fields, prefix with - for descending order
+var taskSortFields = map[string]string{
+	"created_at": task.FieldCreatedAt,
+	"updated_at": task.FieldUpdatedAt,
+	"text":       task.FieldText,
+	"status":     task.FieldStatus,
+	"due_at":     task.FieldDueAt,
+	"position":   task.FieldPosition,
+}
+
+// taskListParams are the query parameters accepted by GET /api/tasks.
+type taskListParams struct {
+	status        []task.Status
+	tags          []string
+	groupID       string
+	overdue       *bool
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	updatedAfter  *time.Time
+	updatedBefore *time.Time
+	search        string
+	// sort is the sort parameter, sortField and sortDesc are parsed from it
+	sort      string
+	sortField string
+	sortDesc  bool
+	limit     int
+	after     *taskCursor
+}
+
+// taskCursor is the position of the last task of a page in the sort order, the next page starts after it. Unlike an
+// offset, it doesn't skip or repeat tasks which are created or deleted between the pages. Value is the sort field of
+// the task, it's nil for a task without a due date.
+type taskCursor struct {
+	Sort  string      `json:"s"`
+	Value interface{} `json:"v"`
+	ID    string      `json:"id"`
+}
+
+func parseTaskListParams(q url.Values) (*taskListParams, error) {
+	p := &taskListParams{
+		sort:      defaultTaskSort,
+		sortField: task.FieldCreatedAt,
+		limit:     defaultPageSize,
+		search:    strings.TrimSpace(q.Get("q")),
+		groupID:   q.Get("group_id"),
+	}
+
+	for _, status := range q["status"] {
+		for _, s := range strings.Split(status, ",") {
+			if err := task.StatusValidator(task.Status(s)); err != nil {
+				return nil, err
+			}
+			p.status = append(p.status, task.Status(s))
+		}
+	}
+
+	for _, tags := range q["tag"] {
+		for _, name := range strings.Split(tags, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				p.tags = append(p.tags, name)
+			}
+		}
+	}
+
+	if overdue := q.Get("overdue"); overdue != "" {
+		b, err := strconv.ParseBool(overdue)
+		if err != nil {
+			return nil, fmt.Errorf("overdue must be true or false")
+		}
+		p.overdue = &b
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"created_after":  &p.createdAfter,
+		"created_before": &p.createdBefore,
+		"updated_after":  &p.updatedAfter,
+		"updated_before": &p.updatedBefore,
+	} {
+		val := q.Get(param)
+		if val == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a RFC3339 timestamp", param)
+		}
+		*dst = &t
+	}
+
+	var err error
+	if sort := q.Get("sort"); sort != "" {
+		p.sort = sort
+		p.sortDesc = strings.HasPrefix(sort, "-")
+		field, ok := taskSortFields[strings.TrimPrefix(sort, "-")]
+		if !ok {
+			return nil, fmt.Errorf("sort must be one of created_at, updated_at, text, status, due_at, position optionally prefixed with -")
+		}
+		p.sortField = field
+	}
+
+	if limit := q.Get("limit"); limit != "" {
+		p.limit, err = strconv.Atoi(limit)
+		if err != nil || p.limit < 1 || p.limit > maxPageSize {
+			return nil, fmt.Errorf("limit must be between 1 and %d", maxPageSize)
+		}
+	}
+
+	if cursor := q.Get("cursor"); cursor != "" {
+		p.after, err = decodeCursor(cursor, p.sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// apply narrows down the query with the filters, order and page of the params. One extra row is requested to
+// find out if there is a next page.
+func (p *taskListParams) apply(query *models.TaskQuery) *models.TaskQuery {
+	if len(p.status) > 0 {
+		query = query.Where(task.StatusIn(p.status...))
+	}
+	if len(p.tags) > 0 {
+		query = query.Where(task.HasTagsWith(tag.NameIn(p.tags...)))
+	}
+	if p.groupID != "" {
+		query = query.Where(task.HasGroupWith(group.ID(p.groupID)))
+	}
+	if p.overdue != nil {
+		if *p.overdue {
+			query = query.Where(taskOverdue())
+		} else {
+			query = query.Where(task.Or(task.DueAtIsNil(), task.Not(taskOverdue())))
+		}
+	}
+	if p.createdAfter != nil {
+		query = query.Where(task.CreatedAtGTE(*p.createdAfter))
+	}
+	if p.createdBefore != nil {
+		query = query.Where(task.CreatedAtLT(*p.createdBefore))
+	}
+	if p.updatedAfter != nil {
+		query = query.Where(task.UpdatedAtGTE(*p.updatedAfter))
+	}
+	if p.updatedBefore != nil {
+		query = query.Where(task.UpdatedAtLT(*p.updatedBefore))
+	}
+	if p.search != "" {
+		query = query.Where(task.TextContainsFold(p.search))
+	}
+	if p.after != nil {
+		query = query.Where(p.afterCursor())
+	}
+
+	order := models.Asc
+	if p.sortDesc {
+		order = models.Desc
+	}
+	if p.sortField == task.FieldDueAt {
+		// databases disagree on where nulls are sorted, tasks without a due date come last in ascending order
+		query = query.Order(func(s *sql.Selector, _ func(string) bool) {
+			nulls := s.C(task.FieldDueAt) + " IS NULL"
+			if p.sortDesc {
+				nulls += " DESC"
+			}
+			s.OrderBy(nulls)
+		})
+	}
+
+	// the id keeps the order stable between pages when the sort field has duplicates
+	return query.
+		Order(order(p.sortField, task.FieldID)).
+		Limit(p.limit + 1)
+}
+
+// afterCursor selects the tasks which come after the cursor in the order of apply.
+func (p *taskListParams) afterCursor() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		col, id := s.C(p.sortField), s.C(task.FieldID)
+		after := sql.GT
+		if p.sortDesc {
+			after = sql.LT
+		}
+
+		value := p.after.Value
+		if value == nil {
+			// only a due date can be null, the null due dates come last in ascending and first in descending order
+			afterNulls := sql.And(sql.IsNull(col), after(id, p.after.ID))
+			if p.sortDesc {
+				s.Where(sql.Or(sql.NotNull(col), afterNulls))
+			} else {
+				s.Where(afterNulls)
+			}
+			return
+		}
+
+		afterValue := sql.Or(after(col, value), sql.And(sql.EQ(col, value), after(id, p.after.ID)))
+		if p.sortField == task.FieldDueAt && !p.sortDesc {
+			afterValue = sql.Or(afterValue, sql.IsNull(col))
+		}
+		s.Where(afterValue)
+	}
+}
+
+// page trims the extra row requested by apply and returns the cursor of the next page if there is one.
+func (p *taskListParams) page(tasks []*models.Task) ([]*models.Task, string) {
+	if len(tasks) <= p.limit {
+		return tasks, ""
+	}
+	tasks = tasks[:p.limit]
+	return tasks, encodeCursor(p.sort, p.sortField, tasks[len(tasks)-1])
+}
+
+// taskSortValue returns the value of the sort field of the task as it's stored in a cursor.
+func taskSortValue(t *models.Task, field string) interface{} {
+	switch field {
+	case task.FieldCreatedAt:
+		return t.CreatedAt.Format(time.RFC3339Nano)
+	case task.FieldUpdatedAt:
+		return t.UpdatedAt.Format(time.RFC3339Nano)
+	case task.FieldDueAt:
+		if t.DueAt == nil {
+			return nil
+		}
+		return t.DueAt.Format(time.RFC3339Nano)
+	case task.FieldText:
+		return t.Text
+	case task.FieldStatus:
+		return string(t.Status)
+	case task.FieldPosition:
+		return t.Position
+	}
+	return nil
+}
+
+func encodeCursor(sort, field string, last *models.Task) string {
+	b, _ := json.Marshal(taskCursor{Sort: sort, Value: taskSortValue(last, field), ID: last.ID})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor returns the cursor with its value converted to the type of the sort field. A cursor of a list with
+// another sort is invalid.
+func decodeCursor(cursor, sort string) (*taskCursor, error) {
+	invalid := fmt.Errorf("cursor is invalid")
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	c := new(taskCursor)
+	if err := json.Unmarshal(b, c); err != nil || c.ID == "" || c.Sort != sort {
+		return nil, invalid
+	}
+
+	field := taskSortFields[strings.TrimPrefix(sort, "-")]
+	switch v := c.Value.(type) {
+	case nil:
+		if field != task.FieldDueAt {
+			return nil, invalid
+		}
+	case string:
+		switch field {
+		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDueAt:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, invalid
+			}
+			c.Value = t
+		case task.FieldText, task.FieldStatus:
+		default:
+			return nil, invalid
+		}
+	case float64:
+		if field != task.FieldPosition {
+			return nil, invalid
+		}
+		c.Value = int(v)
+	default:
+		return nil, invalid
+	}
+	return c, nil
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+// listAllTasks pages through the tasks with the sort, calling between after every page.
+func listAllTasks(t *testing.T, api http.Handler, token, sort string, between func()) []string {
+	t.Helper()
+	var ids []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 20 {
+			t.Fatal("the pages don't end")
+		}
+		q := url.Values{"sort": {sort}, "limit": {"2"}}
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+		res := new(taskListResponse)
+		if w := apiRequest(t, api, token, http.MethodGet, "/tasks?"+q.Encode(), nil, res); w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d: %s", sort, w.Code, w.Body.String())
+		}
+		for _, listed := range res.Tasks {
+			ids = append(ids, listed.ID)
+		}
+		if res.NextCursor == "" {
+			return ids
+		}
+		cursor = res.NextCursor
+		between()
+	}
+}
+
+func TestTaskListKeysetPages(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	accountID, token := newTestAccount(t, appCtx, "owner@example.com")
+	ctx := context.Background()
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	create := func(text string, status task.Status, position int, due *time.Time, createdAt time.Time) *models.Task {
+		created, err := appCtx.db.Task.Create().
+			SetID(shortuuid.New()).
+			SetOwner(accountID).
+			SetText(text).
+			SetStatus(status).
+			SetPosition(position).
+			SetNillableDueAt(due).
+			SetCreatedAt(createdAt).
+			SetUpdatedAt(createdAt).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return created
+	}
+	due := func(days int) *time.Time {
+		d := base.AddDate(0, 0, days)
+		return &d
+	}
+	// duplicate sort values and missing due dates are ordered by id
+	for i, spec := range []struct {
+		status   task.Status
+		position int
+		due      *time.Time
+	}{
+		{task.StatusTodo, 1, due(3)},
+		{task.StatusDone, 1, nil},
+		{task.StatusTodo, 2, due(1)},
+		{task.StatusInprogress, 0, nil},
+		{task.StatusDone, 2, due(3)},
+		{task.StatusTodo, 3, nil},
+		{task.StatusInprogress, 0, due(2)},
+	} {
+		create(fmt.Sprintf("task %d", i%3), spec.status, spec.position, spec.due, base.Add(time.Duration(i%4)*time.Hour))
+	}
+
+	for _, sort := range []string{"created_at", "-created_at", "updated_at", "text", "-text", "status", "due_at", "-due_at", "position", "-position"} {
+		t.Run(sort, func(t *testing.T) {
+			want := listAllTasks(t, api, token, sort, func() {})
+			res := new(taskListResponse)
+			apiRequest(t, api, token, http.MethodGet, "/tasks?"+url.Values{"sort": {sort}}.Encode(), nil, res)
+			if len(want) != len(res.Tasks) {
+				t.Fatalf("got %d tasks in pages, want %d", len(want), len(res.Tasks))
+			}
+			for i, listed := range res.Tasks {
+				if want[i] != listed.ID {
+					t.Fatalf("task %d: the pages don't match the single page order", i)
+				}
+			}
+		})
+	}
+
+	t.Run("changes between pages", func(t *testing.T) {
+		before := listAllTasks(t, api, token, "created_at", func() {})
+		var deleted []string
+		pages := 0
+		got := listAllTasks(t, api, token, "created_at", func() {
+			pages++
+			// an offset would skip a task once an already listed one is deleted and repeat one once a task is
+			// created before the listed ones
+			create("new", task.StatusTodo, 0, nil, base.Add(-time.Duration(pages)*time.Hour))
+			id := before[pages-1]
+			if err := appCtx.db.Task.DeleteOneID(id).Exec(ctx); err != nil {
+				t.Fatal(err)
+			}
+			deleted = append(deleted, id)
+		})
+
+		seen := map[string]bool{}
+		for _, id := range got {
+			if seen[id] {
+				t.Fatalf("task %s is listed twice", id)
+			}
+			seen[id] = true
+		}
+		isDeleted := map[string]bool{}
+		for _, id := range deleted {
+			isDeleted[id] = true
+		}
+		for _, id := range before {
+			if !isDeleted[id] && !seen[id] {
+				t.Fatalf("task %s was skipped", id)
+			}
+		}
+	})
+
+	t.Run("cursor of another sort", func(t *testing.T) {
+		res := new(taskListResponse)
+		apiRequest(t, api, token, http.MethodGet, "/tasks?limit=1&sort=text", nil, res)
+		q := url.Values{"sort": {"created_at"}, "cursor": {res.NextCursor}}
+		if w := apiRequest(t, api, token, http.MethodGet, "/tasks?"+q.Encode(), nil, nil); w.Code != http.StatusBadRequest {
+			t.Fatalf("got %d, want %d", w.Code, http.StatusBadRequest)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+// Request and response payloads of the task api. The responses are the public contract of the api and are never
+// encoded from the ent entities directly, so a new schema field is only exposed once it's added to newTaskResponse.
+// The payloads are also used to generate the openapi spec.
+
+type createTaskRequest struct {
+	Text string `json:"text"`
+	taskFieldsRequest
+}
+
+func (req *createTaskRequest) Bind(r *http.Request) error {
+	v := new(validator)
+	v.taskText("text", req.Text)
+	req.taskFieldsRequest.validate(v)
+	return v.err()
+}
+
+type updateTaskStatusRequest struct {
+	Status string `json:"status"`
+}
+
+func (req *updateTaskStatusRequest) Bind(r *http.Request) error {
+	v := new(validator)
+	v.taskStatus("status", req.Status)
+	return v.err()
+}
+
+type updateTaskTextRequest struct {
+	Text string `json:"text"`
+}
+
+func (req *updateTaskTextRequest) Bind(r *http.Request) error {
+	v := new(validator)
+	v.taskText("text", req.Text)
+	return v.err()
+}
+
+type patchTaskRequest struct {
+	Text   *string `json:"text,omitempty"`
+	Status *string `json:"status,omitempty"`
+	taskFieldsRequest
+}
+
+func (req *patchTaskRequest) Bind(r *http.Request) error {
+	v := new(validator)
+	if req.Text != nil {
+		v.taskText("text", *req.Text)
+	}
+	if req.Status != nil {
+		v.taskStatus("status", *req.Status)
+	}
+	req.taskFieldsRequest.validate(v)
+	return v.err()
+}
+
+type taskResponse struct {
+	ID        string        `json:"id"`
+	Text      string        `json:"text"`
+	Status    task.Status   `json:"status"`
+	Priority  task.Priority `json:"priority"`
+	DueAt     *time.Time    `json:"due_at,omitempty"`
+	Overdue   bool          `json:"overdue"`
+	Position  int           `json:"position"`
+	Tags      []string      `json:"tags"`
+	GroupID   string        `json:"group_id,omitempty"`
+	GroupName string        `json:"group_name,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	Version   int           `json:"version"`
+}
+
+// newTaskResponse controls which fields of a task are visible to api and webhook clients. The tags and the group of
+// the task need to be loaded, see loadTask.
+func newTaskResponse(t *models.Task) *taskResponse {
+	res := &taskResponse{
+		ID:        t.ID,
+		Text:      t.Text,
+		Status:    t.Status,
+		Priority:  t.Priority,
+		DueAt:     t.DueAt,
+		Overdue:   isOverdue(t),
+		Position:  t.Position,
+		Tags:      tagNames(t),
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+		Version:   t.Version,
+	}
+	if t.Edges.Group != nil {
+		res.GroupID = t.Edges.Group.ID
+		res.GroupName = t.Edges.Group.Name
+	}
+	return res
+}
+
+// newTaskResponses renders the tasks of the api list and of the app page.
+func newTaskResponses(tasks []*models.Task) []*taskResponse {
+	res := make([]*taskResponse, 0, len(tasks))
+	for _, t := range tasks {
+		res = append(res, newTaskResponse(t))
+	}
+	return res
+}
+
+func (res *taskResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+type taskListResponse struct {
+	Tasks      []*taskResponse `json:"tasks"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+func newTaskListResponse(tasks []*models.Task, nextCursor string) *taskListResponse {
+	return &taskListResponse{
+		Tasks:      newTaskResponses(tasks),
+		NextCursor: nextCursor,
+	}
+}
+
+func (res *taskListResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+type deleteTaskResponse struct {
+	Success bool `json:"success"`
+}
+
+func (res *deleteTaskResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// renderBindErr responds with the field errors of an invalid payload or a bad request if it couldn't be decoded.
+func renderBindErr(w http.ResponseWriter, r *http.Request, err error) {
+	if _, ok := err.(fieldErrors); ok {
+		render.Render(w, r, ErrValidation(err))
+		return
+	}
+	render.Render(w, r, ErrInvalidRequest(err))
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// taskResponseFields are the fields of a task in the api, a change of the list is a change of the public contract.
+var taskResponseFields = []string{
+	"created_at", "due_at", "id", "overdue", "position", "priority", "status", "tags", "text", "updated_at", "version",
+}
+
+func jsonKeys(t *testing.T, raw json.RawMessage) []string {
+	t.Helper()
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestTaskResponseFields(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	_, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	// the list of a new account is an empty array rather than null
+	w := apiRequest(t, api, token, http.MethodGet, "/tasks", nil, nil)
+	if body := strings.TrimSpace(w.Body.String()); body != `{"tasks":[]}` {
+		t.Fatalf("got %s, want an empty list", body)
+	}
+
+	dueAt := "2030-01-02T15:04:05Z"
+	w = apiRequest(t, api, token, http.MethodPost, "/tasks", map[string]interface{}{
+		"text": "a task", "due_at": dueAt, "tags": []string{"home"},
+	}, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d: %s", w.Code, w.Body.String())
+	}
+	created := new(taskResponse)
+	if err := json.Unmarshal(w.Body.Bytes(), created); err != nil {
+		t.Fatal(err)
+	}
+	var list struct {
+		Tasks []json.RawMessage `json:"tasks"`
+	}
+	apiRequest(t, api, token, http.MethodGet, "/tasks", nil, &list)
+	if len(list.Tasks) != 1 {
+		t.Fatalf("got %d tasks, want 1", len(list.Tasks))
+	}
+
+	for name, raw := range map[string]json.RawMessage{
+		"create": w.Body.Bytes(),
+		"get":    apiRequest(t, api, token, http.MethodGet, "/tasks/"+created.ID, nil, nil).Body.Bytes(),
+		"list":   list.Tasks[0],
+	} {
+		// the owner and the ent edges aren't exposed
+		if got := strings.Join(jsonKeys(t, raw), ","); got != strings.Join(taskResponseFields, ",") {
+			t.Errorf("%s: got fields %s, want %s", name, got, strings.Join(taskResponseFields, ","))
+		}
+	}
+
+	if created.Text != "a task" || created.DueAt == nil || created.DueAt.Format(time.RFC3339) != dueAt ||
+		len(created.Tags) != 1 || created.Tags[0] != "home" {
+		t.Fatalf("got %+v, want the fields of the created task", created)
+	}
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestTaskAPICrossTenantAccess(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	_, ownerToken := newTestAccount(t, appCtx, "owner@example.com")
+	_, otherToken := newTestAccount(t, appCtx, "other@example.com")
+
+	owned := new(taskResponse)
+	w := apiRequest(t, api, ownerToken, http.MethodPost, "/tasks", map[string]string{"text": "owned task"}, owned)
+	if w.Code != http.StatusOK {
+		t.Fatalf("create: got %d: %s", w.Code, w.Body.String())
+	}
+	other := new(taskResponse)
+	apiRequest(t, api, otherToken, http.MethodPost, "/tasks", map[string]string{"text": "other task"}, other)
+
+	path := "/tasks/" + owned.ID
+	if w := apiRequest(t, api, ownerToken, http.MethodGet, path, nil, nil); w.Code != http.StatusOK {
+		t.Fatalf("get by the owner: got %d: %s", w.Code, w.Body.String())
+	}
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   interface{}
+	}{
+		{"get", http.MethodGet, path, nil},
+		{"patch", http.MethodPatch, path, map[string]string{"text": "changed"}},
+		{"update status", http.MethodPut, path + "/status", map[string]string{"status": "done"}},
+		{"update text", http.MethodPut, path + "/text", map[string]string{"text": "changed"}},
+		{"delete", http.MethodDelete, path, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := apiRequest(t, api, otherToken, tt.method, tt.path, tt.body, nil)
+			if w.Code != http.StatusNotFound {
+				t.Fatalf("got %d, want %d: %s", w.Code, http.StatusNotFound, w.Body.String())
+			}
+		})
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		text := "changed"
+		res := new(batchTasksResponse)
+		w := apiRequest(t, api, otherToken, http.MethodPost, "/tasks/batch", batchTasksRequest{
+			Mode: bestEffortBatchMode,
+			Operations: []batchTaskOperation{
+				{Op: updateBatchOp, ID: owned.ID, Text: &text},
+				{Op: deleteBatchOp, ID: owned.ID},
+				{Op: updateBatchOp, ID: other.ID, Text: &text},
+			},
+		}, res)
+		if w.Code != http.StatusOK {
+			t.Fatalf("got %d: %s", w.Code, w.Body.String())
+		}
+		for i, want := range []int{http.StatusNotFound, http.StatusNotFound, http.StatusOK} {
+			if res.Results[i].Status != want {
+				t.Errorf("operation %d: got %d, want %d", i, res.Results[i].Status, want)
+			}
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		res := new(taskListResponse)
+		apiRequest(t, api, otherToken, http.MethodGet, "/tasks", nil, res)
+		for _, listed := range res.Tasks {
+			if listed.ID == owned.ID {
+				t.Fatal("the task of another account is listed")
+			}
+		}
+	})
+
+	stored, err := appCtx.db.Task.Get(context.Background(), owned.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Text != "owned task" || stored.Status != owned.Status {
+		t.Fatalf("the task was changed by another account: %q %s", stored.Text, stored.Status)
+	}
+}
+
+func TestTaskAPICrossWorkspaceHeader(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	ownerID, ownerToken := newTestAccount(t, appCtx, "owner@example.com")
+	_, otherToken := newTestAccount(t, appCtx, "other@example.com")
+
+	owned := new(taskResponse)
+	apiRequest(t, api, ownerToken, http.MethodPost, "/tasks", map[string]string{"text": "owned task"}, owned)
+
+	// selecting the personal workspace of another account is forbidden
+	req := newAPIRequest(t, otherToken, http.MethodGet, "/tasks/"+owned.ID, nil)
+	req.Header.Set(workspaceHeader, ownerID)
+	w := serve(api, req)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("got %d, want %d: %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+}
@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/adnaan/authn"
+	authnmodels "github.com/adnaan/authn/models"
+	"github.com/adnaan/authn/models/account"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+)
+
+// apiKeyAttribute is the account attribute where the api keys were stored before api tokens, see migrateAPIKeys.
+const apiKeyAttribute = "api_key"
+
+// isAPIAuthenticated accepts either an `Authorization: Bearer <token>` header carrying the branca encoded
+// api token generated on the account page or falls back to the session cookie checked by authn.IsAuthenticated.
+// On success the account id is stored in the request context so that authn.AccountIDFromContext works for both.
+func isAPIAuthenticated(appCtx Context) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		sessionAuthenticated := appCtx.authn.IsAuthenticated(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+			if authorization == "" {
+				sessionAuthenticated.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := bearerToken(authorization)
+			if !ok {
+				render.Render(w, r, ErrUnauthorized(fmt.Errorf("authorization header must be of the form: Bearer <token>")))
+				return
+			}
+
+			accountID, err := accountIDFromAPIToken(r.Context(), appCtx, token)
+			if err != nil {
+				render.Render(w, r, ErrUnauthorized(err))
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), authn.AccountIDKey, accountID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(authorization string) (string, bool) {
+	parts := strings.SplitN(authorization, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return "", false
+	}
+	token := strings.TrimSpace(parts[1])
+	if token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+// accountIDFromAPIToken decodes the branca token and returns the id of the account whose api token has the hash of
+// the decoded api key.
+func accountIDFromAPIToken(ctx context.Context, appCtx Context, token string) (string, error) {
+	apiKey, err := appCtx.branca.DecodeToString(token)
+	if err != nil || apiKey == "" {
+		return "", fmt.Errorf("api token is invalid")
+	}
+
+	apiToken, err := appCtx.db.APIToken.Query().Where(apitoken.KeyHash(hashAPIKey(apiKey))).Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return "", fmt.Errorf("api token is invalid")
+		}
+		return "", fmt.Errorf("%v %w", err, authn.ErrInternal)
+	}
+
+	accountID, err := uuid.Parse(apiToken.Owner)
+	if err != nil {
+		return "", fmt.Errorf("api token is invalid")
+	}
+	acc, err := appCtx.accounts.Account.Get(ctx, accountID)
+	if err != nil {
+		if authnmodels.IsNotFound(err) {
+			return "", fmt.Errorf("api token is invalid")
+		}
+		return "", fmt.Errorf("%v %w", err, authn.ErrInternal)
+	}
+	if acc.Locked {
+		return "", fmt.Errorf("api token is invalid")
+	}
+
+	return acc.ID.String(), nil
+}
+
+// hashAPIKey returns the hex encoded sha256 of the api key. The key is random, so it doesn't need a salt.
+func hashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// setAPIKey replaces the api token of the account with the one of the api key, the previous token stops working.
+func setAPIKey(ctx context.Context, appCtx Context, accountID, apiKey string) error {
+	return withTx(ctx, appCtx, func(txCtx Context) error {
+		_, err := txCtx.db.APIToken.Delete().Where(apitoken.Owner(accountID)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = txCtx.db.APIToken.Create().
+			SetOwner(accountID).
+			SetKeyHash(hashAPIKey(apiKey)).
+			Save(ctx)
+		return err
+	})
+}
+
+// migrateAPIKeys moves the api keys stored as the api_key attribute of the accounts to api tokens. The tokens issued
+// before keep working.
+func migrateAPIKeys(ctx context.Context, appCtx Context) error {
+	// the attributes column is json, sqlite doesn't ship with json1 so narrow down with a text match
+	// and check the decoded attributes below.
+	candidates, err := appCtx.accounts.Account.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("CAST(").Ident(s.C(account.FieldAttributes)).WriteString(" AS TEXT) LIKE ").Arg("%" + apiKeyAttribute + "%")
+			}))
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, acc := range candidates {
+		apiKey, ok := authn.M(acc.Attributes).String(apiKeyAttribute)
+		if !ok {
+			continue
+		}
+		if err := setAPIKey(ctx, appCtx, acc.ID.String(), apiKey); err != nil {
+			return fmt.Errorf("migrating the api key of %s: %w", acc.ID, err)
+		}
+		attributes := make(map[string]interface{}, len(acc.Attributes))
+		for k, v := range acc.Attributes {
+			if k != apiKeyAttribute {
+				attributes[k] = v
+			}
+		}
+		if err := acc.Update().SetAttributes(attributes).Exec(ctx); err != nil {
+			return fmt.Errorf("migrating the api key of %s: %w", acc.ID, err)
+		}
+	}
+	return nil
+}
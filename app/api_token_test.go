@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+)
+
+func TestAPITokenAuthentication(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	ctx := context.Background()
+	accountID, revokedToken := newTestAccount(t, appCtx, "owner@example.com")
+	lockedID, lockedToken := newTestAccount(t, appCtx, "locked@example.com")
+	deletedID, deletedToken := newTestAccount(t, appCtx, "deleted@example.com")
+
+	// regenerating the token revokes the previous one
+	apiKey := shortuuid.New()
+	if err := setAPIKey(ctx, appCtx, accountID, apiKey); err != nil {
+		t.Fatal(err)
+	}
+	token, err := appCtx.branca.EncodeToString(apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownToken, err := appCtx.branca.EncodeToString(shortuuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = appCtx.accounts.Account.UpdateOneID(uuid.MustParse(lockedID)).SetLocked(true).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a token left behind by an account deleted outside of the account page
+	err = appCtx.accounts.Account.DeleteOneID(uuid.MustParse(deletedID)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tt := range map[string]struct {
+		authorization string
+		want          int
+	}{
+		"valid":           {"Bearer " + token, http.StatusOK},
+		"revoked":         {"Bearer " + revokedToken, http.StatusUnauthorized},
+		"unknown api key": {"Bearer " + unknownToken, http.StatusUnauthorized},
+		"not branca":      {"Bearer not-a-token", http.StatusUnauthorized},
+		"locked account":  {"Bearer " + lockedToken, http.StatusUnauthorized},
+		"deleted account": {"Bearer " + deletedToken, http.StatusUnauthorized},
+		"not bearer":      {"Token " + token, http.StatusUnauthorized},
+	} {
+		req := newAPIRequest(t, "", http.MethodGet, "/tasks", nil)
+		req.Header.Set("Authorization", tt.authorization)
+		if w := serve(api, req); w.Code != tt.want {
+			t.Errorf("%s: got %d, want %d", name, w.Code, tt.want)
+		}
+	}
+}
+
+func TestMigrateAPIKeys(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	ctx := context.Background()
+
+	apiKey := shortuuid.New()
+	acc, err := appCtx.accounts.Account.Create().
+		SetProvider("email").
+		SetEmail("owner@example.com").
+		SetPassword("password1234").
+		SetConfirmed(true).
+		SetAttributes(map[string]interface{}{apiKeyAttribute: apiKey, "name": "owner"}).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := appCtx.branca.EncodeToString(apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// migrating twice is a no-op
+	for i := 0; i < 2; i++ {
+		if err := migrateAPIKeys(ctx, appCtx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if w := serve(api, newAPIRequest(t, token, http.MethodGet, "/tasks", nil)); w.Code != http.StatusOK {
+		t.Fatalf("got %d, want the token issued before the migration to work", w.Code)
+	}
+	if n := appCtx.db.APIToken.Query().Where(apitoken.Owner(acc.ID.String())).CountX(ctx); n != 1 {
+		t.Fatalf("got %d api tokens, want 1", n)
+	}
+	migrated, err := appCtx.accounts.Account.Get(ctx, acc.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := migrated.Attributes[apiKeyAttribute]; ok {
+		t.Fatal("the api key is still stored in the account attributes")
+	}
+	if migrated.Attributes["name"] != "owner" {
+		t.Fatalf("got attributes %v, want the other attributes kept", migrated.Attributes)
+	}
+}
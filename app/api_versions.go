@@ -0,0 +1,122 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+)
+
+// currentAPIVersion is also served at the bare /api
+const currentAPIVersion = "v1"
+
+// apiVersion is a set of routes mounted at /api/{name}. A new version gets its own routes and operations, so its
+// payloads can change without breaking the clients of the older versions.
+type apiVersion struct {
+	name       string
+	routes     func(r chi.Router, appCtx Context)
+	operations map[string]apiOperation
+	// set once a newer version replaces this one to emit the Deprecation and Sunset headers
+	deprecated time.Time
+	sunset     time.Time
+	successor  string
+}
+
+// apiVersions are mounted by Router. To add a version, append it here, set deprecated, sunset and successor on the
+// version it replaces and update currentAPIVersion.
+var apiVersions = []apiVersion{
+	{
+		name:       "v1",
+		routes:     v1Routes,
+		operations: v1Operations,
+	},
+}
+
+func v1Routes(r chi.Router, appCtx Context) {
+	// a call is metered once it's allowed, a forbidden call doesn't use up the quota
+	meter := meterAPICalls(appCtx)
+	canRead := appCtx.RequirePermission(permTasksRead)
+	read := chi.Chain(canRead, meter)
+	write := chi.Chain(appCtx.RequirePermission(permTasksWrite), meter)
+	manageGroups := chi.Chain(appCtx.RequirePermission(permGroupsManage), meter)
+
+	// a stream is a single long lived call, it isn't metered
+	r.With(canRead).Get("/tasks/events", streamTaskEvents(appCtx))
+
+	r.Route("/tasks", func(r chi.Router) {
+		r.With(read...).Get("/", list(appCtx))
+		r.With(write...).With(idempotent(appCtx)).Post("/", create(appCtx))
+	})
+	r.With(write...).With(idempotent(appCtx)).Post("/tasks/batch", batch(appCtx))
+	r.Route("/tasks/{id}", func(r chi.Router) {
+		r.With(read...).Get("/", get(appCtx))
+		r.With(write...).Patch("/", patch(appCtx))
+		r.With(write...).Put("/status", updateStatus(appCtx))
+		r.With(write...).Put("/text", updateText(appCtx))
+		r.With(write...).Delete("/", delete(appCtx))
+	})
+
+	r.Route("/groups", func(r chi.Router) {
+		r.With(read...).Get("/", listGroups(appCtx))
+		r.With(manageGroups...).Post("/", createGroup(appCtx))
+	})
+	r.Route("/groups/{id}", func(r chi.Router) {
+		r.With(read...).Get("/", getGroup(appCtx))
+		r.With(manageGroups...).Patch("/", patchGroup(appCtx))
+		r.With(manageGroups...).Delete("/", deleteGroup(appCtx))
+	})
+}
+
+// apiVersionRoutes returns the authenticated routes of the version. The routes of a version meter the api calls with
+// meterAPICalls themselves.
+func apiVersionRoutes(appCtx Context, version apiVersion) chi.Router {
+	routes := chi.NewRouter()
+	routes.Use(isAPIAuthenticated(appCtx))
+	routes.Use(withWorkspace(appCtx))
+	routes.Use(middleware.AllowContentType("application/json"))
+	version.routes(routes, appCtx)
+	return routes
+}
+
+// apiVersionRouter returns the authenticated routes of the version along with its openapi spec at /openapi.json.
+// The routes without an apiOperation are served but missing from the spec, TestAPIOperations catches them.
+func apiVersionRouter(appCtx Context, version apiVersion) (chi.Router, error) {
+	routes := apiVersionRoutes(appCtx, version)
+	spec, undocumented, err := openAPISpec(appCtx.cfg, "/api/"+version.name, version, routes)
+	if err != nil {
+		return nil, err
+	}
+	if len(undocumented) > 0 {
+		log.Printf("openapi: %s routes without an apiOperation: %s", version.name, strings.Join(undocumented, ", "))
+	}
+
+	r := chi.NewRouter()
+	r.Use(deprecationHeaders(version))
+	r.Get("/openapi.json", serveOpenAPISpec(spec))
+	r.Mount("/", routes)
+	return r, nil
+}
+
+// deprecationHeaders sets the Deprecation (RFC 9745), Sunset (RFC 8594) and successor Link headers of a
+// deprecated version.
+func deprecationHeaders(version apiVersion) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if version.deprecated.IsZero() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", fmt.Sprintf("@%d", version.deprecated.Unix()))
+			if !version.sunset.IsZero() {
+				w.Header().Set("Sunset", version.sunset.UTC().Format(http.TimeFormat))
+			}
+			if version.successor != "" {
+				w.Header().Set("Link", fmt.Sprintf("</api/%s>; rel=\"successor-version\"", version.successor))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
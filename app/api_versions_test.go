@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDeprecatedAPIVersionHeaders(t *testing.T) {
+	appCtx := newTestContext(t)
+	_, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	deprecated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	// v0 is a version replaced by the current one
+	versions := map[string]apiVersion{
+		"v0": {
+			name:       "v0",
+			routes:     v1Routes,
+			operations: v1Operations,
+			deprecated: deprecated,
+			sunset:     sunset,
+			successor:  currentAPIVersion,
+		},
+	}
+	for _, version := range apiVersions {
+		if version.name == currentAPIVersion {
+			versions[version.name] = version
+		}
+	}
+
+	for name, version := range versions {
+		handler, err := apiVersionRouter(appCtx, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, path := range []string{"/tasks", "/openapi.json"} {
+			w := serve(handler, newAPIRequest(t, token, http.MethodGet, path, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("%s %s: got %d", name, path, w.Code)
+			}
+
+			want := map[string]string{"Deprecation": "", "Sunset": "", "Link": ""}
+			if !version.deprecated.IsZero() {
+				want = map[string]string{
+					"Deprecation": fmt.Sprintf("@%d", deprecated.Unix()),
+					"Sunset":      "Wed, 01 Jul 2026 00:00:00 GMT",
+					"Link":        fmt.Sprintf(`</api/%s>; rel="successor-version"`, currentAPIVersion),
+				}
+			}
+			for header, value := range want {
+				if got := w.Header().Get(header); got != value {
+					t.Errorf("%s %s: got %s %q, want %q", name, path, header, got, value)
+				}
+			}
+		}
+	}
+}
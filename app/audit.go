@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/render"
+	"github.com/lithammer/shortuuid/v3"
+
+	authnaccount "github.com/adnaan/authn/models/account"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/auditevent"
+)
+
+const (
+	// the number of audit events listed on the account page, all of them are exported
+	auditEventsPageSize = 50
+	auditExportBatch    = 500
+	maxUserAgentLength  = 512
+)
+
+// audit actions
+const (
+	auditLoginSucceeded       = "login.succeeded"
+	auditLoginFailed          = "login.failed"
+	auditMagicLinkSent        = "login.magic_link_sent"
+	auditAccountUpdated       = "account.updated"
+	auditEmailChangeRequested = "account.email_change_requested"
+	auditEmailChanged         = "account.email_changed"
+	auditAPITokenRegenerated  = "account.api_token_regenerated"
+	auditAccountDeleted       = "account.deleted"
+	auditCheckoutStarted      = "billing.checkout_started"
+	auditWebhookReceived      = "webhook.received"
+	webhookActorPrefix        = "webhook:"
+	// the actor of an event caused by an unknown account
+	anonymousActor = "anonymous"
+)
+
+// auditDiff is the diff of an audit event, see auditChange.
+type auditDiff map[string]interface{}
+
+// auditChange is the diff entry of a changed field.
+func auditChange(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"from": from, "to": to}
+}
+
+// auditEventResponse is an audit event listed on the account page and a line of the export.
+type auditEventResponse struct {
+	ID        string                 `json:"id"`
+	AccountID string                 `json:"account_id,omitempty"`
+	Actor     string                 `json:"actor"`
+	Action    string                 `json:"action"`
+	IP        string                 `json:"ip,omitempty"`
+	UserAgent string                 `json:"user_agent,omitempty"`
+	Diff      map[string]interface{} `json:"diff,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+func newAuditEventResponse(e *models.AuditEvent) auditEventResponse {
+	return auditEventResponse{
+		ID:        e.ID,
+		AccountID: e.AccountID,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		Diff:      e.Diff,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// DiffJSON is the diff as shown on the account page.
+func (e auditEventResponse) DiffJSON() string {
+	if len(e.Diff) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(e.Diff)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// recordAuditEvent appends an event to the audit log. The account id is empty if the event isn't about a known
+// account and the actor is anonymous if it's empty. Like emitTaskEvent, a failure is logged and doesn't fail the request which caused the event.
+func recordAuditEvent(r *http.Request, appCtx Context, accountID, actor, action string, diff auditDiff) {
+	userAgent := r.UserAgent()
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	if actor == "" {
+		actor = anonymousActor
+	}
+	create := appCtx.db.AuditEvent.Create().
+		SetID(shortuuid.New()).
+		SetAccountID(accountID).
+		SetActor(actor).
+		SetAction(action).
+		SetIP(clientIP(r)).
+		SetUserAgent(userAgent)
+	if len(diff) > 0 {
+		create.SetDiff(diff)
+	}
+	// the request context may be canceled once the response is written
+	_, err := create.Save(context.Background())
+	if err != nil {
+		log.Printf("recordAuditEvent %s %s: %v", action, accountID, err)
+	}
+}
+
+// clientIP returns the ip of the remote address without its port.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// accountIDByEmail returns the id of the account with the email or an empty string if there is none.
+func accountIDByEmail(ctx context.Context, appCtx Context, email string) string {
+	acc, err := appCtx.accounts.Account.Query().Where(authnaccount.Email(email)).Only(ctx)
+	if err != nil {
+		return ""
+	}
+	return acc.ID.String()
+}
+
+// accountAuditEvents returns the latest audit events of the account for the account page.
+func accountAuditEvents(ctx context.Context, appCtx Context, accountID string) ([]auditEventResponse, error) {
+	events, err := appCtx.db.AuditEvent.Query().
+		Where(auditevent.AccountID(accountID)).
+		Order(models.Desc(auditevent.FieldCreatedAt)).
+		Limit(auditEventsPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]auditEventResponse, 0, len(events))
+	for _, e := range events {
+		res = append(res, newAuditEventResponse(e))
+	}
+	return res, nil
+}
+
+// exportAuditEvents writes all the audit events of the account as JSON Lines, oldest first.
+func exportAuditEvents(appCtx Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		accountID := authn.AccountIDFromContext(r)
+		query := appCtx.db.AuditEvent.Query().
+			Where(auditevent.AccountID(accountID)).
+			Order(models.Asc(auditevent.FieldCreatedAt), models.Asc(auditevent.FieldID))
+
+		events, err := query.Clone().Limit(auditExportBatch).All(r.Context())
+		if err != nil {
+			render.Render(w, r, ErrInternal(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="audit-events.jsonl"`)
+		enc := json.NewEncoder(w)
+		for offset := 0; len(events) > 0; {
+			for _, e := range events {
+				if err := enc.Encode(newAuditEventResponse(e)); err != nil {
+					log.Printf("exportAuditEvents %s: %v", accountID, err)
+					return
+				}
+			}
+			if len(events) < auditExportBatch {
+				return
+			}
+			offset += len(events)
+			events, err = query.Clone().Offset(offset).Limit(auditExportBatch).All(r.Context())
+			if err != nil {
+				// the status is already written, the export ends early
+				log.Printf("exportAuditEvents %s: %v", accountID, err)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/auditevent"
+)
+
+func TestAuditEventsAreAppendOnly(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+	recordAuditEvent(httptest.NewRequest(http.MethodGet, "/", nil), appCtx, "account", "account", auditLoginSucceeded, nil)
+	audited, err := appCtx.db.AuditEvent.Query().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, op := range map[string]func() error{
+		"update":     func() error { return appCtx.db.AuditEvent.Update().Exec(ctx) },
+		"update one": func() error { return audited.Update().Exec(ctx) },
+		"delete":     func() error { _, err := appCtx.db.AuditEvent.Delete().Exec(ctx); return err },
+		"delete one": func() error { return appCtx.db.AuditEvent.DeleteOne(audited).Exec(ctx) },
+	} {
+		if err := op(); err == nil {
+			t.Errorf("%s: the audit event can be changed", name)
+		}
+	}
+	if count, err := appCtx.db.AuditEvent.Query().Where(auditevent.ID(audited.ID)).Count(ctx); err != nil || count != 1 {
+		t.Fatalf("got %d audit events, %v", count, err)
+	}
+}
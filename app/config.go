@@ -48,6 +48,22 @@ type Config struct {
 	StripePublishableKey string         `json:"stripe_publishable_key" envconfig:"stripe_publishable_key"`
 	StripeSecretKey      string         `json:"stripe_secret_key" envconfig:"stripe_secret_key"`
 	StripeWebhookSecret  string         `json:"stripe_webhook_secret" envconfig:"stripe_webhook_secret"`
+	PlanCacheTTLSecs     int            `json:"plan_cache_ttl_secs" envconfig:"plan_cache_ttl_secs" default:"60"`
+
+	// webhooks
+	WebhookMaxAttempts       int                   `json:"webhook_max_attempts" envconfig:"webhook_max_attempts" default:"10"`
+	WebhookRetryIntervalSecs int                   `json:"webhook_retry_interval_secs" envconfig:"webhook_retry_interval_secs" default:"30"`
+	WebhookSourcesFile       string                `json:"webhook_sources_file" envconfig:"webhook_sources_file"`
+	WebhookSources           []WebhookSourceConfig `json:"-" envconfig:"-"`
+	// allows webhook endpoints on loopback and private networks, only meant for local development
+	WebhookAllowPrivateURLs bool `json:"webhook_allow_private_urls" envconfig:"webhook_allow_private_urls"`
+
+	// invitations
+	InvitationTTLHours int `json:"invitation_ttl_hours" envconfig:"invitation_ttl_hours" default:"168"`
+
+	// workspace roles
+	RolesFile string `json:"roles_file" envconfig:"roles_file" default:"roles.development.json"`
+	Roles     []Role `json:"-" envconfig:"-"`
 }
 
 type FeatureGroup struct {
@@ -61,6 +77,23 @@ type Feature struct {
 	ValueType string `json:"value_type"`
 }
 
+// Role grants its permissions to the workspace members with the role of the same name.
+type Role struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// WebhookSourceConfig configures an incoming webhook source received at /webhook/{name}.
+// Type is either "hmac" or "github". The header fields only apply to the "hmac" type.
+type WebhookSourceConfig struct {
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	Secret          string `json:"secret"`
+	SignatureHeader string `json:"signature_header"`
+	EventIDHeader   string `json:"event_id_header"`
+	EventTypeHeader string `json:"event_type_header"`
+}
+
 type Plan struct {
 	PriceID  string                 `json:"price_id"`
 	Name     string                 `json:"name"`
@@ -99,6 +132,20 @@ func LoadConfig(configFile string, envPrefix string) (Config, error) {
 		fmt.Printf("err loading feature groups file %v, err %v \n", config.FeatureGroupsFile, err)
 	}
 
+	webhookSources, err := loadWebhookSources(config.WebhookSourcesFile)
+	if err == nil {
+		config.WebhookSources = webhookSources
+	} else {
+		fmt.Printf("err loading webhook sources file %v, err %v \n", config.WebhookSourcesFile, err)
+	}
+
+	roles, err := loadRoles(config.RolesFile)
+	if err == nil {
+		config.Roles = roles
+	} else {
+		fmt.Printf("err loading roles file %v, err %v \n", config.RolesFile, err)
+	}
+
 	return config, nil
 }
 
@@ -152,6 +199,56 @@ func loadFeatureGroups(file string) ([]FeatureGroup, error) {
 	return featureGroups, nil
 }
 
+func loadWebhookSources(file string) ([]WebhookSourceConfig, error) {
+	if file == "" {
+		return []WebhookSourceConfig{}, nil
+	}
+
+	var data []byte
+	var err error
+
+	data, err = base64.StdEncoding.DecodeString(file) // check if string is base64 data
+	if err != nil {
+		data, err = ioutil.ReadFile(file) // or is a file path
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var webhookSources []WebhookSourceConfig
+	err = json.Unmarshal(data, &webhookSources)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookSources, nil
+}
+
+func loadRoles(file string) ([]Role, error) {
+	if file == "" {
+		return []Role{}, nil
+	}
+
+	var data []byte
+	var err error
+
+	data, err = base64.StdEncoding.DecodeString(file) // check if string is base64 data
+	if err != nil {
+		data, err = ioutil.ReadFile(file) // or is a file path
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var roles []Role
+	err = json.Unmarshal(data, &roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
 func loadEnvironment(filename string) error {
 	var err error
 	if filename != "" {
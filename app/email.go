@@ -14,17 +14,49 @@ import (
 	"github.com/matcornic/hermes/v2"
 )
 
-func sendEmailFunc(cfg Config) authn.SendMailFunc {
+// mailer renders hermes emails and sends them from the admin email.
+type mailer struct {
+	appName string
+	from    string
+	h       hermes.Hermes
+	pool    *email.Pool
+}
+
+func newMailer(cfg Config) *mailer {
 	appName := strings.Title(strings.ToLower(cfg.Name))
-	h := hermes.Hermes{
-		Product: hermes.Product{
-			Name: appName,
-			Link: cfg.Domain,
-			//Logo: "https://github.com/matcornic/hermes/blob/master/examples/gopher.png?raw=true",
+	return &mailer{
+		appName: appName,
+		from:    cfg.SMTPAdminEmail,
+		h: hermes.Hermes{
+			Product: hermes.Product{
+				Name: appName,
+				Link: cfg.Domain,
+				//Logo: "https://github.com/matcornic/hermes/blob/master/examples/gopher.png?raw=true",
+			},
 		},
+		pool: newEmailPool(cfg),
+	}
+}
+
+func (m *mailer) send(sendTo, subject string, emailTmpl hermes.Email) error {
+	res, err := m.h.GenerateHTML(emailTmpl)
+	if err != nil {
+		return err
+	}
+
+	e := &email.Email{
+		To:      []string{sendTo},
+		Subject: subject,
+		HTML:    []byte(res),
+		Headers: textproto.MIMEHeader{},
+		From:    m.from,
 	}
 
-	pool := newEmailPool(cfg)
+	return m.pool.Send(e, 20*time.Second)
+}
+
+func sendEmailFunc(cfg Config, m *mailer) authn.SendMailFunc {
+	appName := m.appName
 	return func(mailType authn.MailType, token, sendTo string, metadata map[string]interface{}) error {
 		var name string
 		var ok bool
@@ -53,20 +85,7 @@ func sendEmailFunc(cfg Config) authn.SendMailFunc {
 			emailTmpl = magic(appName, name, fmt.Sprintf("%s/magic-login/%s", cfg.Domain, token))
 		}
 
-		res, err := h.GenerateHTML(emailTmpl)
-		if err != nil {
-			return err
-		}
-
-		e := &email.Email{
-			To:      []string{sendTo},
-			Subject: subject,
-			HTML:    []byte(res),
-			Headers: textproto.MIMEHeader{},
-			From:    cfg.SMTPAdminEmail,
-		}
-
-		return pool.Send(e, 20*time.Second)
+		return m.send(sendTo, subject, emailTmpl)
 	}
 }
 
@@ -167,6 +186,29 @@ func magic(appName, name, link string) hermes.Email {
 	}
 }
 
+func invite(appName, workspaceName, inviter, link string) hermes.Email {
+	return hermes.Email{
+		Body: hermes.Body{
+			Intros: []string{
+				fmt.Sprintf("%s has invited you to join the %s workspace on %s.", inviter, workspaceName, appName),
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "Click the button below to accept or decline the invitation:",
+					Button: hermes.Button{
+						Text: "View invitation",
+						Link: link,
+					},
+				},
+			},
+			Outros: []string{
+				"If you don't know the sender, no further action is required on your part.",
+			},
+			Signature: "Thanks",
+		},
+	}
+}
+
 func newEmailPool(cfg Config) *email.Pool {
 
 	var pool *email.Pool
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// features enforced by the app. Their values are set per plan in the plans file and typed in the feature groups file.
+const (
+	freePriceID   = "free"
+	tasksFeature  = "tasks"
+	intValueType  = "int"
+	boolValueType = "bool"
+)
+
+// limitError is returned when the current plan doesn't allow the requested usage of a feature.
+type limitError struct {
+	Plan    string
+	Feature string
+	Limit   int64
+}
+
+func (e *limitError) Error() string {
+	if e.Limit == 0 {
+		return fmt.Sprintf("the %s plan doesn't include %s, please upgrade your plan", e.Plan, e.Feature)
+	}
+	return fmt.Sprintf("the %s plan is limited to %d %s, please upgrade your plan", e.Plan, e.Limit, e.Feature)
+}
+
+// currentPlan resolves the plan of the current workspace, see workspacePlan.
+func currentPlan(appCtx Context, r *http.Request) Plan {
+	return workspacePlan(r.Context(), appCtx, workspaceIDFromContext(r))
+}
+
+// workspacePlan resolves the plan of the workspace from the active subscription of its owner, the members of a
+// workspace share the plan of its owner. The free plan is returned if the owner isn't subscribed.
+func workspacePlan(ctx context.Context, appCtx Context, workspaceID string) Plan {
+	ownerID, billingID, err := workspaceOwner(ctx, appCtx, workspaceID)
+	if err != nil {
+		log.Println("workspaceOwner", err)
+	}
+
+	priceID, err := currentPriceID(ctx, appCtx, ownerID, billingID)
+	if err != nil {
+		log.Println("currentPriceID", err)
+	}
+	if priceID == "" {
+		priceID = freePriceID
+	}
+	return planByPriceID(appCtx.cfg.Plans, priceID)
+}
+
+func planByPriceID(plans []Plan, priceID string) Plan {
+	var free *Plan
+	for i := range plans {
+		if plans[i].PriceID == priceID {
+			return plans[i]
+		}
+		if plans[i].PriceID == freePriceID {
+			free = &plans[i]
+		}
+	}
+	if free != nil {
+		return *free
+	}
+	return Plan{PriceID: freePriceID, Name: "Free"}
+}
+
+// featureValueType returns the value type declared for the feature in the feature groups file.
+func (c Config) featureValueType(featureID string) (string, bool) {
+	for _, group := range c.FeatureGroups {
+		for _, feature := range group.Features {
+			if feature.ID == featureID {
+				return feature.ValueType, true
+			}
+		}
+	}
+	return "", false
+}
+
+// checkFeature verifies that the plan allows using the feature given the current usage.
+// An int feature is a quota which must be greater than used, a bool feature must be true.
+// Features which are not declared or not set for the plan are not enforced.
+func checkFeature(cfg Config, plan Plan, featureID string, used int64) error {
+	valueType, ok := cfg.featureValueType(featureID)
+	if !ok {
+		return nil
+	}
+
+	switch valueType {
+	case intValueType:
+		limit, ok := intFeatureLimit(cfg, plan, featureID)
+		if !ok {
+			return nil
+		}
+		if used >= limit {
+			return &limitError{Plan: plan.Name, Feature: featureID, Limit: limit}
+		}
+	case boolValueType:
+		v, ok := plan.Features[featureID]
+		if !ok {
+			return nil
+		}
+		if enabled, ok := v.(bool); ok && !enabled {
+			return &limitError{Plan: plan.Name, Feature: featureID}
+		}
+	}
+
+	return nil
+}
+
+// intFeatureLimit returns the quota of an int feature, it's false if the quota isn't enforced for the plan.
+func intFeatureLimit(cfg Config, plan Plan, featureID string) (int64, bool) {
+	valueType, ok := cfg.featureValueType(featureID)
+	if !ok || valueType != intValueType {
+		return 0, false
+	}
+	limit := float64FromMap(plan.Features, featureID)
+	if limit == nil {
+		return 0, false
+	}
+	return int64(*limit), true
+}
+
+// newTaskWithinLimit is newTask if the plan of the workspace allows another task in it. The tasks are counted and the
+// task is created in one transaction which holds the lock of the workspace, so concurrent creates can't go past the
+// limit.
+func newTaskWithinLimit(ctx context.Context, appCtx Context, workspaceID, text string, fields taskFieldsRequest) (*models.Task, error) {
+	plan := workspacePlan(ctx, appCtx, workspaceID)
+	var created *models.Task
+	err := withTx(ctx, appCtx, func(txCtx Context) error {
+		if err := lockWorkspace(ctx, txCtx, workspaceID); err != nil {
+			return err
+		}
+		count, err := txCtx.db.Task.Query().Where(task.Owner(workspaceID)).Count(ctx)
+		if err != nil {
+			return err
+		}
+		if err := checkFeature(txCtx.cfg, plan, tasksFeature, int64(count)); err != nil {
+			return err
+		}
+		created, err = createTask(ctx, txCtx, workspaceID, text, fields)
+		return err
+	})
+	return created, err
+}
+
+// lockWorkspace writes the workspace row in the transaction of appCtx, the concurrent transactions which lock the
+// same workspace wait for it to end.
+func lockWorkspace(ctx context.Context, appCtx Context, workspaceID string) error {
+	locked, err := appCtx.db.Workspace.Update().
+		Where(workspace.ID(workspaceID)).
+		SetLockedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if locked == 0 {
+		return fmt.Errorf("workspace %s not found", workspaceID)
+	}
+	return nil
+}
@@ -24,6 +24,8 @@ type ErrResponse struct {
 	StatusText string `json:"status"`          // user-level status message
 	AppCode    int64  `json:"code,omitempty"`  // application-specific error code
 	ErrorText  string `json:"error,omitempty"` // application-level error message, for debugging
+
+	Fields map[string]string `json:"fields,omitempty"` // per-field validation errors
 }
 
 func (e *ErrResponse) Render(w http.ResponseWriter, r *http.Request) error {
@@ -40,6 +42,19 @@ func ErrInvalidRequest(err error) render.Renderer {
 	}
 }
 
+func ErrValidation(err error) render.Renderer {
+	e := &ErrResponse{
+		Err:            err,
+		HTTPStatusCode: 422,
+		StatusText:     "Validation failed.",
+		ErrorText:      fmt.Sprintf("%v", err),
+	}
+	if fe, ok := err.(fieldErrors); ok {
+		e.Fields = fe
+	}
+	return e
+}
+
 func ErrRender(err error) render.Renderer {
 	return &ErrResponse{
 		Err:            err,
@@ -67,4 +82,35 @@ func ErrUnauthorized(err error) render.Renderer {
 	}
 }
 
+func ErrForbidden(err error) render.Renderer {
+	return &ErrResponse{
+		Err:            err,
+		HTTPStatusCode: 403,
+		StatusText:     "Forbidden.",
+		ErrorText:      fmt.Sprintf("%v", err),
+	}
+}
+
+func ErrPreconditionFailed(err error) render.Renderer {
+	return &ErrResponse{
+		Err:            err,
+		HTTPStatusCode: 412,
+		StatusText:     "Precondition failed.",
+		ErrorText:      fmt.Sprintf("%v", err),
+	}
+}
+
+func ErrLimitExceeded(err error) render.Renderer {
+	return &ErrResponse{
+		Err:            err,
+		HTTPStatusCode: 403,
+		StatusText:     "Plan limit exceeded.",
+		ErrorText:      fmt.Sprintf("%v", err),
+	}
+}
+
 var ErrNotFound = &ErrResponse{HTTPStatusCode: 404, StatusText: "Resource not found."}
+
+var ErrIdempotencyKeyReused = &ErrResponse{HTTPStatusCode: 422, StatusText: "Idempotency-Key was used with a different request."}
+
+var ErrIdempotencyKeyInProgress = &ErrResponse{HTTPStatusCode: 409, StatusText: "A request with this Idempotency-Key is in progress."}
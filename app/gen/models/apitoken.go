@@ -0,0 +1,122 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+)
+
+// APIToken is the model entity for the APIToken schema.
+type APIToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// KeyHash holds the value of the "key_hash" field.
+	KeyHash string `json:"key_hash,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIToken) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apitoken.FieldID:
+			values[i] = &sql.NullInt64{}
+		case apitoken.FieldOwner, apitoken.FieldKeyHash:
+			values[i] = &sql.NullString{}
+		case apitoken.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type APIToken", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIToken fields.
+func (at *APIToken) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apitoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = int(value.Int64)
+		case apitoken.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				at.Owner = value.String
+			}
+		case apitoken.FieldKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_hash", values[i])
+			} else if value.Valid {
+				at.KeyHash = value.String
+			}
+		case apitoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this APIToken.
+// Note that you need to call APIToken.Unwrap() before calling this method if this APIToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *APIToken) Update() *APITokenUpdateOne {
+	return (&APITokenClient{config: at.config}).UpdateOne(at)
+}
+
+// Unwrap unwraps the APIToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *APIToken) Unwrap() *APIToken {
+	tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("models: APIToken is not a transactional entity")
+	}
+	at.config.driver = tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *APIToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIToken(")
+	builder.WriteString(fmt.Sprintf("id=%v", at.ID))
+	builder.WriteString(", owner=")
+	builder.WriteString(at.Owner)
+	builder.WriteString(", key_hash=")
+	builder.WriteString(at.KeyHash)
+	builder.WriteString(", created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APITokens is a parsable slice of APIToken.
+type APITokens []*APIToken
+
+func (at APITokens) config(cfg config) {
+	for _i := range at {
+		at[_i].config = cfg
+	}
+}
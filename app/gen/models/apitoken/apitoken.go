@@ -0,0 +1,45 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package apitoken
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the apitoken type in the database.
+	Label = "api_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldKeyHash holds the string denoting the key_hash field in the database.
+	FieldKeyHash = "key_hash"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the apitoken in the database.
+	Table = "api_tokens"
+)
+
+// Columns holds all SQL columns for apitoken fields.
+var Columns = []string{
+	FieldID,
+	FieldOwner,
+	FieldKeyHash,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,444 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package apitoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwner), v))
+	})
+}
+
+// KeyHash applies equality check predicate on the "key_hash" field. It's identical to KeyHashEQ.
+func KeyHash(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.APIToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOwner), v...))
+	})
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.APIToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOwner), v...))
+	})
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOwner), v))
+	})
+}
+
+// KeyHashEQ applies the EQ predicate on the "key_hash" field.
+func KeyHashEQ(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashNEQ applies the NEQ predicate on the "key_hash" field.
+func KeyHashNEQ(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashIn applies the In predicate on the "key_hash" field.
+func KeyHashIn(vs ...string) predicate.APIToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKeyHash), v...))
+	})
+}
+
+// KeyHashNotIn applies the NotIn predicate on the "key_hash" field.
+func KeyHashNotIn(vs ...string) predicate.APIToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKeyHash), v...))
+	})
+}
+
+// KeyHashGT applies the GT predicate on the "key_hash" field.
+func KeyHashGT(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashGTE applies the GTE predicate on the "key_hash" field.
+func KeyHashGTE(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashLT applies the LT predicate on the "key_hash" field.
+func KeyHashLT(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashLTE applies the LTE predicate on the "key_hash" field.
+func KeyHashLTE(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashContains applies the Contains predicate on the "key_hash" field.
+func KeyHashContains(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashHasPrefix applies the HasPrefix predicate on the "key_hash" field.
+func KeyHashHasPrefix(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashHasSuffix applies the HasSuffix predicate on the "key_hash" field.
+func KeyHashHasSuffix(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashEqualFold applies the EqualFold predicate on the "key_hash" field.
+func KeyHashEqualFold(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashContainsFold applies the ContainsFold predicate on the "key_hash" field.
+func KeyHashContainsFold(v string) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKeyHash), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.APIToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.APIToken {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.APIToken(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APIToken) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APIToken) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APIToken) predicate.APIToken {
+	return predicate.APIToken(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,237 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+)
+
+// APITokenCreate is the builder for creating a APIToken entity.
+type APITokenCreate struct {
+	config
+	mutation *APITokenMutation
+	hooks    []Hook
+}
+
+// SetOwner sets the "owner" field.
+func (atc *APITokenCreate) SetOwner(s string) *APITokenCreate {
+	atc.mutation.SetOwner(s)
+	return atc
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (atc *APITokenCreate) SetKeyHash(s string) *APITokenCreate {
+	atc.mutation.SetKeyHash(s)
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *APITokenCreate) SetCreatedAt(t time.Time) *APITokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *APITokenCreate) SetNillableCreatedAt(t *time.Time) *APITokenCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// Mutation returns the APITokenMutation object of the builder.
+func (atc *APITokenCreate) Mutation() *APITokenMutation {
+	return atc.mutation
+}
+
+// Save creates the APIToken in the database.
+func (atc *APITokenCreate) Save(ctx context.Context) (*APIToken, error) {
+	var (
+		err  error
+		node *APIToken
+	)
+	atc.defaults()
+	if len(atc.hooks) == 0 {
+		if err = atc.check(); err != nil {
+			return nil, err
+		}
+		node, err = atc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = atc.check(); err != nil {
+				return nil, err
+			}
+			atc.mutation = mutation
+			node, err = atc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(atc.hooks) - 1; i >= 0; i-- {
+			mut = atc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *APITokenCreate) SaveX(ctx context.Context) *APIToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *APITokenCreate) defaults() {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := apitoken.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *APITokenCreate) check() error {
+	if _, ok := atc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New("models: missing required field \"owner\"")}
+	}
+	if _, ok := atc.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New("models: missing required field \"key_hash\"")}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	return nil
+}
+
+func (atc *APITokenCreate) sqlSave(ctx context.Context) (*APIToken, error) {
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (atc *APITokenCreate) createSpec() (*APIToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIToken{config: atc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: apitoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		}
+	)
+	if value, ok := atc.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := atc.mutation.KeyHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldKeyHash,
+		})
+		_node.KeyHash = value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// APITokenCreateBulk is the builder for creating many APIToken entities in bulk.
+type APITokenCreateBulk struct {
+	config
+	builders []*APITokenCreate
+}
+
+// Save creates the APIToken entities in the database.
+func (atcb *APITokenCreateBulk) Save(ctx context.Context) ([]*APIToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*APIToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APITokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *APITokenCreateBulk) SaveX(ctx context.Context) []*APIToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
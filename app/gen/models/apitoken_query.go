@@ -0,0 +1,895 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// APITokenQuery is the builder for querying APIToken entities.
+type APITokenQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.APIToken
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the APITokenQuery builder.
+func (atq *APITokenQuery) Where(ps ...predicate.APIToken) *APITokenQuery {
+	atq.predicates = append(atq.predicates, ps...)
+	return atq
+}
+
+// Limit adds a limit step to the query.
+func (atq *APITokenQuery) Limit(limit int) *APITokenQuery {
+	atq.limit = &limit
+	return atq
+}
+
+// Offset adds an offset step to the query.
+func (atq *APITokenQuery) Offset(offset int) *APITokenQuery {
+	atq.offset = &offset
+	return atq
+}
+
+// Order adds an order step to the query.
+func (atq *APITokenQuery) Order(o ...OrderFunc) *APITokenQuery {
+	atq.order = append(atq.order, o...)
+	return atq
+}
+
+// First returns the first APIToken entity from the query.
+// Returns a *NotFoundError when no APIToken was found.
+func (atq *APITokenQuery) First(ctx context.Context) (*APIToken, error) {
+	nodes, err := atq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{apitoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (atq *APITokenQuery) FirstX(ctx context.Context) *APIToken {
+	node, err := atq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first APIToken ID from the query.
+// Returns a *NotFoundError when no APIToken ID was found.
+func (atq *APITokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = atq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{apitoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (atq *APITokenQuery) FirstIDX(ctx context.Context) int {
+	id, err := atq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single APIToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one APIToken entity is not found.
+// Returns a *NotFoundError when no APIToken entities are found.
+func (atq *APITokenQuery) Only(ctx context.Context) (*APIToken, error) {
+	nodes, err := atq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{apitoken.Label}
+	default:
+		return nil, &NotSingularError{apitoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (atq *APITokenQuery) OnlyX(ctx context.Context) *APIToken {
+	node, err := atq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only APIToken ID in the query.
+// Returns a *NotSingularError when exactly one APIToken ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (atq *APITokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = atq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = &NotSingularError{apitoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (atq *APITokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := atq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of APITokens.
+func (atq *APITokenQuery) All(ctx context.Context) ([]*APIToken, error) {
+	if err := atq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return atq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (atq *APITokenQuery) AllX(ctx context.Context) []*APIToken {
+	nodes, err := atq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of APIToken IDs.
+func (atq *APITokenQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := atq.Select(apitoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (atq *APITokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := atq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (atq *APITokenQuery) Count(ctx context.Context) (int, error) {
+	if err := atq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return atq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (atq *APITokenQuery) CountX(ctx context.Context) int {
+	count, err := atq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (atq *APITokenQuery) Exist(ctx context.Context) (bool, error) {
+	if err := atq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return atq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (atq *APITokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := atq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the APITokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (atq *APITokenQuery) Clone() *APITokenQuery {
+	if atq == nil {
+		return nil
+	}
+	return &APITokenQuery{
+		config:     atq.config,
+		limit:      atq.limit,
+		offset:     atq.offset,
+		order:      append([]OrderFunc{}, atq.order...),
+		predicates: append([]predicate.APIToken{}, atq.predicates...),
+		// clone intermediate query.
+		sql:  atq.sql.Clone(),
+		path: atq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.APIToken.Query().
+//		GroupBy(apitoken.FieldOwner).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (atq *APITokenQuery) GroupBy(field string, fields ...string) *APITokenGroupBy {
+	group := &APITokenGroupBy{config: atq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return atq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//	}
+//
+//	client.APIToken.Query().
+//		Select(apitoken.FieldOwner).
+//		Scan(ctx, &v)
+//
+func (atq *APITokenQuery) Select(field string, fields ...string) *APITokenSelect {
+	atq.fields = append([]string{field}, fields...)
+	return &APITokenSelect{APITokenQuery: atq}
+}
+
+func (atq *APITokenQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range atq.fields {
+		if !apitoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if atq.path != nil {
+		prev, err := atq.path(ctx)
+		if err != nil {
+			return err
+		}
+		atq.sql = prev
+	}
+	return nil
+}
+
+func (atq *APITokenQuery) sqlAll(ctx context.Context) ([]*APIToken, error) {
+	var (
+		nodes = []*APIToken{}
+		_spec = atq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &APIToken{config: atq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, atq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (atq *APITokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := atq.querySpec()
+	return sqlgraph.CountNodes(ctx, atq.driver, _spec)
+}
+
+func (atq *APITokenQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := atq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (atq *APITokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apitoken.Table,
+			Columns: apitoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+		From:   atq.sql,
+		Unique: true,
+	}
+	if fields := atq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apitoken.FieldID)
+		for i := range fields {
+			if fields[i] != apitoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := atq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := atq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := atq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := atq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, apitoken.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (atq *APITokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(atq.driver.Dialect())
+	t1 := builder.Table(apitoken.Table)
+	selector := builder.Select(t1.Columns(apitoken.Columns...)...).From(t1)
+	if atq.sql != nil {
+		selector = atq.sql
+		selector.Select(selector.Columns(apitoken.Columns...)...)
+	}
+	for _, p := range atq.predicates {
+		p(selector)
+	}
+	for _, p := range atq.order {
+		p(selector, apitoken.ValidColumn)
+	}
+	if offset := atq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := atq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// APITokenGroupBy is the group-by builder for APIToken entities.
+type APITokenGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (atgb *APITokenGroupBy) Aggregate(fns ...AggregateFunc) *APITokenGroupBy {
+	atgb.fns = append(atgb.fns, fns...)
+	return atgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (atgb *APITokenGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := atgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	atgb.sql = query
+	return atgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (atgb *APITokenGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := atgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("models: APITokenGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (atgb *APITokenGroupBy) StringsX(ctx context.Context) []string {
+	v, err := atgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = atgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (atgb *APITokenGroupBy) StringX(ctx context.Context) string {
+	v, err := atgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("models: APITokenGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (atgb *APITokenGroupBy) IntsX(ctx context.Context) []int {
+	v, err := atgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = atgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (atgb *APITokenGroupBy) IntX(ctx context.Context) int {
+	v, err := atgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("models: APITokenGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (atgb *APITokenGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := atgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = atgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (atgb *APITokenGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := atgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("models: APITokenGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (atgb *APITokenGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := atgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (atgb *APITokenGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = atgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (atgb *APITokenGroupBy) BoolX(ctx context.Context) bool {
+	v, err := atgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (atgb *APITokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range atgb.fields {
+		if !apitoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := atgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := atgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (atgb *APITokenGroupBy) sqlQuery() *sql.Selector {
+	selector := atgb.sql
+	columns := make([]string, 0, len(atgb.fields)+len(atgb.fns))
+	columns = append(columns, atgb.fields...)
+	for _, fn := range atgb.fns {
+		columns = append(columns, fn(selector, apitoken.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(atgb.fields...)
+}
+
+// APITokenSelect is the builder for selecting fields of APIToken entities.
+type APITokenSelect struct {
+	*APITokenQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ats *APITokenSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ats.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ats.sql = ats.APITokenQuery.sqlQuery(ctx)
+	return ats.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ats *APITokenSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ats.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("models: APITokenSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ats *APITokenSelect) StringsX(ctx context.Context) []string {
+	v, err := ats.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ats.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ats *APITokenSelect) StringX(ctx context.Context) string {
+	v, err := ats.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("models: APITokenSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ats *APITokenSelect) IntsX(ctx context.Context) []int {
+	v, err := ats.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ats.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ats *APITokenSelect) IntX(ctx context.Context) int {
+	v, err := ats.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("models: APITokenSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ats *APITokenSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ats.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ats.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ats *APITokenSelect) Float64X(ctx context.Context) float64 {
+	v, err := ats.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("models: APITokenSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ats *APITokenSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ats.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ats.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("models: APITokenSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ats *APITokenSelect) BoolX(ctx context.Context) bool {
+	v, err := ats.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ats *APITokenSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ats.sqlQuery().Query()
+	if err := ats.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ats *APITokenSelect) sqlQuery() sql.Querier {
+	selector := ats.sql
+	selector.Select(selector.Columns(ats.fields...)...)
+	return selector
+}
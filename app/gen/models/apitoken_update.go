@@ -0,0 +1,264 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/apitoken"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// APITokenUpdate is the builder for updating APIToken entities.
+type APITokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APITokenMutation
+}
+
+// Where adds a new predicate for the APITokenUpdate builder.
+func (atu *APITokenUpdate) Where(ps ...predicate.APIToken) *APITokenUpdate {
+	atu.mutation.predicates = append(atu.mutation.predicates, ps...)
+	return atu
+}
+
+// SetOwner sets the "owner" field.
+func (atu *APITokenUpdate) SetOwner(s string) *APITokenUpdate {
+	atu.mutation.SetOwner(s)
+	return atu
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (atu *APITokenUpdate) SetKeyHash(s string) *APITokenUpdate {
+	atu.mutation.SetKeyHash(s)
+	return atu
+}
+
+// Mutation returns the APITokenMutation object of the builder.
+func (atu *APITokenUpdate) Mutation() *APITokenMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *APITokenUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(atu.hooks) == 0 {
+		affected, err = atu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			atu.mutation = mutation
+			affected, err = atu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(atu.hooks) - 1; i >= 0; i-- {
+			mut = atu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *APITokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *APITokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *APITokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (atu *APITokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apitoken.Table,
+			Columns: apitoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+	}
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldOwner,
+		})
+	}
+	if value, ok := atu.mutation.KeyHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldKeyHash,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apitoken.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// APITokenUpdateOne is the builder for updating a single APIToken entity.
+type APITokenUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *APITokenMutation
+}
+
+// SetOwner sets the "owner" field.
+func (atuo *APITokenUpdateOne) SetOwner(s string) *APITokenUpdateOne {
+	atuo.mutation.SetOwner(s)
+	return atuo
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (atuo *APITokenUpdateOne) SetKeyHash(s string) *APITokenUpdateOne {
+	atuo.mutation.SetKeyHash(s)
+	return atuo
+}
+
+// Mutation returns the APITokenMutation object of the builder.
+func (atuo *APITokenUpdateOne) Mutation() *APITokenMutation {
+	return atuo.mutation
+}
+
+// Save executes the query and returns the updated APIToken entity.
+func (atuo *APITokenUpdateOne) Save(ctx context.Context) (*APIToken, error) {
+	var (
+		err  error
+		node *APIToken
+	)
+	if len(atuo.hooks) == 0 {
+		node, err = atuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			atuo.mutation = mutation
+			node, err = atuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(atuo.hooks) - 1; i >= 0; i-- {
+			mut = atuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *APITokenUpdateOne) SaveX(ctx context.Context) *APIToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *APITokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *APITokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (atuo *APITokenUpdateOne) sqlSave(ctx context.Context) (_node *APIToken, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apitoken.Table,
+			Columns: apitoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+	}
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing APIToken.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldOwner,
+		})
+	}
+	if value, ok := atuo.mutation.KeyHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldKeyHash,
+		})
+	}
+	_node = &APIToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apitoken.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,166 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/auditevent"
+)
+
+// AuditEvent is the model entity for the AuditEvent schema.
+type AuditEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Diff holds the value of the "diff" field.
+	Diff map[string]interface{} `json:"diff,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldDiff:
+			values[i] = &[]byte{}
+		case auditevent.FieldID, auditevent.FieldAccountID, auditevent.FieldActor, auditevent.FieldAction, auditevent.FieldIP, auditevent.FieldUserAgent:
+			values[i] = &sql.NullString{}
+		case auditevent.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEvent fields.
+func (ae *AuditEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ae.ID = value.String
+			}
+		case auditevent.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				ae.AccountID = value.String
+			}
+		case auditevent.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ae.Actor = value.String
+			}
+		case auditevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ae.Action = value.String
+			}
+		case auditevent.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				ae.IP = value.String
+			}
+		case auditevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ae.UserAgent = value.String
+			}
+		case auditevent.FieldDiff:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field diff", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Diff); err != nil {
+					return fmt.Errorf("unmarshal field diff: %w", err)
+				}
+			}
+		case auditevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditEvent.
+// Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEvent) Update() *AuditEventUpdateOne {
+	return (&AuditEventClient{config: ae.config}).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEvent) Unwrap() *AuditEvent {
+	tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("models: AuditEvent is not a transactional entity")
+	}
+	ae.config.driver = tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v", ae.ID))
+	builder.WriteString(", account_id=")
+	builder.WriteString(ae.AccountID)
+	builder.WriteString(", actor=")
+	builder.WriteString(ae.Actor)
+	builder.WriteString(", action=")
+	builder.WriteString(ae.Action)
+	builder.WriteString(", ip=")
+	builder.WriteString(ae.IP)
+	builder.WriteString(", user_agent=")
+	builder.WriteString(ae.UserAgent)
+	builder.WriteString(", diff=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Diff))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEvents is a parsable slice of AuditEvent.
+type AuditEvents []*AuditEvent
+
+func (ae AuditEvents) config(cfg config) {
+	for _i := range ae {
+		ae[_i].config = cfg
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the auditevent type in the database.
+	Label = "audit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldDiff holds the string denoting the diff field in the database.
+	FieldDiff = "diff"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditevent in the database.
+	Table = "audit_events"
+)
+
+// Columns holds all SQL columns for auditevent fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldActor,
+	FieldAction,
+	FieldIP,
+	FieldUserAgent,
+	FieldDiff,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/adnaan/gomodest-starter/app/gen/models/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
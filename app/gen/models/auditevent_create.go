@@ -0,0 +1,323 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/auditevent"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (aec *AuditEventCreate) SetAccountID(s string) *AuditEventCreate {
+	aec.mutation.SetAccountID(s)
+	return aec
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableAccountID(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetAccountID(*s)
+	}
+	return aec
+}
+
+// SetActor sets the "actor" field.
+func (aec *AuditEventCreate) SetActor(s string) *AuditEventCreate {
+	aec.mutation.SetActor(s)
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEventCreate) SetAction(s string) *AuditEventCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetIP sets the "ip" field.
+func (aec *AuditEventCreate) SetIP(s string) *AuditEventCreate {
+	aec.mutation.SetIP(s)
+	return aec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableIP(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetIP(*s)
+	}
+	return aec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aec *AuditEventCreate) SetUserAgent(s string) *AuditEventCreate {
+	aec.mutation.SetUserAgent(s)
+	return aec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableUserAgent(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetUserAgent(*s)
+	}
+	return aec
+}
+
+// SetDiff sets the "diff" field.
+func (aec *AuditEventCreate) SetDiff(m map[string]interface{}) *AuditEventCreate {
+	aec.mutation.SetDiff(m)
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEventCreate) SetCreatedAt(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreatedAt(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEventCreate) SetID(s string) *AuditEventCreate {
+	aec.mutation.SetID(s)
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	var (
+		err  error
+		node *AuditEvent
+	)
+	aec.defaults()
+	if len(aec.hooks) == 0 {
+		if err = aec.check(); err != nil {
+			return nil, err
+		}
+		node, err = aec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aec.check(); err != nil {
+				return nil, err
+			}
+			aec.mutation = mutation
+			node, err = aec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aec.hooks) - 1; i >= 0; i-- {
+			mut = aec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New("models: missing required field \"actor\"")}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New("models: missing required field \"action\"")}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: auditevent.FieldID,
+			},
+		}
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.AccountID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldAccountID,
+		})
+		_node.AccountID = value
+	}
+	if value, ok := aec.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.IP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldIP,
+		})
+		_node.IP = value
+	}
+	if value, ok := aec.mutation.UserAgent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldUserAgent,
+		})
+		_node.UserAgent = value
+	}
+	if value, ok := aec.mutation.Diff(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditevent.FieldDiff,
+		})
+		_node.Diff = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,260 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/auditevent"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where adds a new predicate for the AuditEventUpdate builder.
+func (aeu *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	aeu.mutation.predicates = append(aeu.mutation.predicates, ps...)
+	return aeu
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeu *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(aeu.hooks) == 0 {
+		affected, err = aeu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aeu.mutation = mutation
+			affected, err = aeu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(aeu.hooks) - 1; i >= 0; i-- {
+			mut = aeu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aeu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aeu *AuditEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditevent.Table,
+			Columns: auditevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: auditevent.FieldID,
+			},
+		},
+	}
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aeu.mutation.AccountIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditevent.FieldAccountID,
+		})
+	}
+	if aeu.mutation.IPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditevent.FieldIP,
+		})
+	}
+	if aeu.mutation.UserAgentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditevent.FieldUserAgent,
+		})
+	}
+	if aeu.mutation.DiffCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: auditevent.FieldDiff,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeuo *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return aeuo.mutation
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (aeuo *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	var (
+		err  error
+		node *AuditEvent
+	)
+	if len(aeuo.hooks) == 0 {
+		node, err = aeuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aeuo.mutation = mutation
+			node, err = aeuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aeuo.hooks) - 1; i >= 0; i-- {
+			mut = aeuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aeuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aeuo *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditevent.Table,
+			Columns: auditevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: auditevent.FieldID,
+			},
+		},
+	}
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing AuditEvent.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aeuo.mutation.AccountIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditevent.FieldAccountID,
+		})
+	}
+	if aeuo.mutation.IPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditevent.FieldIP,
+		})
+	}
+	if aeuo.mutation.UserAgentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditevent.FieldUserAgent,
+		})
+	}
+	if aeuo.mutation.DiffCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: auditevent.FieldDiff,
+		})
+	}
+	_node = &AuditEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -24,7 +24,20 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Task []ent.Hook
+	APIToken        []ent.Hook
+	AuditEvent      []ent.Hook
+	Group           []ent.Hook
+	IdempotencyKey  []ent.Hook
+	Invitation      []ent.Hook
+	Membership      []ent.Hook
+	Subscription    []ent.Hook
+	Tag             []ent.Hook
+	Task            []ent.Hook
+	Usage           []ent.Hook
+	WebhookDelivery []ent.Hook
+	WebhookEndpoint []ent.Hook
+	WebhookEvent    []ent.Hook
+	Workspace       []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -0,0 +1,829 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// GroupUpdate is the builder for updating Group entities.
+type GroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupMutation
+}
+
+// Where adds a new predicate for the GroupUpdate builder.
+func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
+	gu.mutation.predicates = append(gu.mutation.predicates, ps...)
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GroupUpdate) SetName(s string) *GroupUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetPermissions sets the "permissions" field.
+func (gu *GroupUpdate) SetPermissions(s []string) *GroupUpdate {
+	gu.mutation.SetPermissions(s)
+	return gu
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (gu *GroupUpdate) ClearPermissions() *GroupUpdate {
+	gu.mutation.ClearPermissions()
+	return gu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gu *GroupUpdate) SetUpdatedAt(t time.Time) *GroupUpdate {
+	gu.mutation.SetUpdatedAt(t)
+	return gu
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (gu *GroupUpdate) SetWorkspaceID(id string) *GroupUpdate {
+	gu.mutation.SetWorkspaceID(id)
+	return gu
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (gu *GroupUpdate) SetWorkspace(w *Workspace) *GroupUpdate {
+	return gu.SetWorkspaceID(w.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the Membership entity by IDs.
+func (gu *GroupUpdate) AddMemberIDs(ids ...string) *GroupUpdate {
+	gu.mutation.AddMemberIDs(ids...)
+	return gu
+}
+
+// AddMembers adds the "members" edges to the Membership entity.
+func (gu *GroupUpdate) AddMembers(m ...*Membership) *GroupUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return gu.AddMemberIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (gu *GroupUpdate) AddTaskIDs(ids ...string) *GroupUpdate {
+	gu.mutation.AddTaskIDs(ids...)
+	return gu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (gu *GroupUpdate) AddTasks(t ...*Task) *GroupUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.AddTaskIDs(ids...)
+}
+
+// Mutation returns the GroupMutation object of the builder.
+func (gu *GroupUpdate) Mutation() *GroupMutation {
+	return gu.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (gu *GroupUpdate) ClearWorkspace() *GroupUpdate {
+	gu.mutation.ClearWorkspace()
+	return gu
+}
+
+// ClearMembers clears all "members" edges to the Membership entity.
+func (gu *GroupUpdate) ClearMembers() *GroupUpdate {
+	gu.mutation.ClearMembers()
+	return gu
+}
+
+// RemoveMemberIDs removes the "members" edge to Membership entities by IDs.
+func (gu *GroupUpdate) RemoveMemberIDs(ids ...string) *GroupUpdate {
+	gu.mutation.RemoveMemberIDs(ids...)
+	return gu
+}
+
+// RemoveMembers removes "members" edges to Membership entities.
+func (gu *GroupUpdate) RemoveMembers(m ...*Membership) *GroupUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return gu.RemoveMemberIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (gu *GroupUpdate) ClearTasks() *GroupUpdate {
+	gu.mutation.ClearTasks()
+	return gu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (gu *GroupUpdate) RemoveTaskIDs(ids ...string) *GroupUpdate {
+	gu.mutation.RemoveTaskIDs(ids...)
+	return gu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (gu *GroupUpdate) RemoveTasks(t ...*Task) *GroupUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	gu.defaults()
+	if len(gu.hooks) == 0 {
+		if err = gu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = gu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GroupMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gu.check(); err != nil {
+				return 0, err
+			}
+			gu.mutation = mutation
+			affected, err = gu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(gu.hooks) - 1; i >= 0; i-- {
+			mut = gu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GroupUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GroupUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gu *GroupUpdate) defaults() {
+	if _, ok := gu.mutation.UpdatedAt(); !ok {
+		v := group.UpdateDefaultUpdatedAt()
+		gu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GroupUpdate) check() error {
+	if _, ok := gu.mutation.WorkspaceID(); gu.mutation.WorkspaceCleared() && !ok {
+		return errors.New("models: clearing a required unique edge \"workspace\"")
+	}
+	return nil
+}
+
+func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   group.Table,
+			Columns: group.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: group.FieldID,
+			},
+		},
+	}
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: group.FieldName,
+		})
+	}
+	if value, ok := gu.mutation.Permissions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: group.FieldPermissions,
+		})
+	}
+	if gu.mutation.PermissionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: group.FieldPermissions,
+		})
+	}
+	if value, ok := gu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: group.FieldUpdatedAt,
+		})
+	}
+	if gu.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.WorkspaceTable,
+			Columns: []string{group.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.WorkspaceTable,
+			Columns: []string{group.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.TasksTable,
+			Columns: []string{group.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: task.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !gu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.TasksTable,
+			Columns: []string{group.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: task.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.TasksTable,
+			Columns: []string{group.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: task.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{group.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// GroupUpdateOne is the builder for updating a single Group entity.
+type GroupUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *GroupMutation
+}
+
+// SetName sets the "name" field.
+func (guo *GroupUpdateOne) SetName(s string) *GroupUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetPermissions sets the "permissions" field.
+func (guo *GroupUpdateOne) SetPermissions(s []string) *GroupUpdateOne {
+	guo.mutation.SetPermissions(s)
+	return guo
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (guo *GroupUpdateOne) ClearPermissions() *GroupUpdateOne {
+	guo.mutation.ClearPermissions()
+	return guo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (guo *GroupUpdateOne) SetUpdatedAt(t time.Time) *GroupUpdateOne {
+	guo.mutation.SetUpdatedAt(t)
+	return guo
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (guo *GroupUpdateOne) SetWorkspaceID(id string) *GroupUpdateOne {
+	guo.mutation.SetWorkspaceID(id)
+	return guo
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (guo *GroupUpdateOne) SetWorkspace(w *Workspace) *GroupUpdateOne {
+	return guo.SetWorkspaceID(w.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the Membership entity by IDs.
+func (guo *GroupUpdateOne) AddMemberIDs(ids ...string) *GroupUpdateOne {
+	guo.mutation.AddMemberIDs(ids...)
+	return guo
+}
+
+// AddMembers adds the "members" edges to the Membership entity.
+func (guo *GroupUpdateOne) AddMembers(m ...*Membership) *GroupUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return guo.AddMemberIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (guo *GroupUpdateOne) AddTaskIDs(ids ...string) *GroupUpdateOne {
+	guo.mutation.AddTaskIDs(ids...)
+	return guo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (guo *GroupUpdateOne) AddTasks(t ...*Task) *GroupUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return guo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the GroupMutation object of the builder.
+func (guo *GroupUpdateOne) Mutation() *GroupMutation {
+	return guo.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (guo *GroupUpdateOne) ClearWorkspace() *GroupUpdateOne {
+	guo.mutation.ClearWorkspace()
+	return guo
+}
+
+// ClearMembers clears all "members" edges to the Membership entity.
+func (guo *GroupUpdateOne) ClearMembers() *GroupUpdateOne {
+	guo.mutation.ClearMembers()
+	return guo
+}
+
+// RemoveMemberIDs removes the "members" edge to Membership entities by IDs.
+func (guo *GroupUpdateOne) RemoveMemberIDs(ids ...string) *GroupUpdateOne {
+	guo.mutation.RemoveMemberIDs(ids...)
+	return guo
+}
+
+// RemoveMembers removes "members" edges to Membership entities.
+func (guo *GroupUpdateOne) RemoveMembers(m ...*Membership) *GroupUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return guo.RemoveMemberIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (guo *GroupUpdateOne) ClearTasks() *GroupUpdateOne {
+	guo.mutation.ClearTasks()
+	return guo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (guo *GroupUpdateOne) RemoveTaskIDs(ids ...string) *GroupUpdateOne {
+	guo.mutation.RemoveTaskIDs(ids...)
+	return guo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (guo *GroupUpdateOne) RemoveTasks(t ...*Task) *GroupUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return guo.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the updated Group entity.
+func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
+	var (
+		err  error
+		node *Group
+	)
+	guo.defaults()
+	if len(guo.hooks) == 0 {
+		if err = guo.check(); err != nil {
+			return nil, err
+		}
+		node, err = guo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GroupMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = guo.check(); err != nil {
+				return nil, err
+			}
+			guo.mutation = mutation
+			node, err = guo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(guo.hooks) - 1; i >= 0; i-- {
+			mut = guo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, guo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GroupUpdateOne) SaveX(ctx context.Context) *Group {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (guo *GroupUpdateOne) defaults() {
+	if _, ok := guo.mutation.UpdatedAt(); !ok {
+		v := group.UpdateDefaultUpdatedAt()
+		guo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GroupUpdateOne) check() error {
+	if _, ok := guo.mutation.WorkspaceID(); guo.mutation.WorkspaceCleared() && !ok {
+		return errors.New("models: clearing a required unique edge \"workspace\"")
+	}
+	return nil
+}
+
+func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   group.Table,
+			Columns: group.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: group.FieldID,
+			},
+		},
+	}
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Group.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: group.FieldName,
+		})
+	}
+	if value, ok := guo.mutation.Permissions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: group.FieldPermissions,
+		})
+	}
+	if guo.mutation.PermissionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: group.FieldPermissions,
+		})
+	}
+	if value, ok := guo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: group.FieldUpdatedAt,
+		})
+	}
+	if guo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.WorkspaceTable,
+			Columns: []string{group.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.WorkspaceTable,
+			Columns: []string{group.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   group.MembersTable,
+			Columns: group.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.TasksTable,
+			Columns: []string{group.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: task.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !guo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.TasksTable,
+			Columns: []string{group.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: task.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   group.TasksTable,
+			Columns: []string{group.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: task.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Group{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{group.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
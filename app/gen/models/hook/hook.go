@@ -9,6 +9,110 @@ import (
 	"github.com/adnaan/gomodest-starter/app/gen/models"
 )
 
+// The APITokenFunc type is an adapter to allow the use of ordinary
+// function as APIToken mutator.
+type APITokenFunc func(context.Context, *models.APITokenMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APITokenFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.APITokenMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.APITokenMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *models.AuditEventMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.AuditEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.AuditEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The GroupFunc type is an adapter to allow the use of ordinary
+// function as Group mutator.
+type GroupFunc func(context.Context, *models.GroupMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.GroupMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.GroupMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *models.IdempotencyKeyMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.IdempotencyKeyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.IdempotencyKeyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InvitationFunc type is an adapter to allow the use of ordinary
+// function as Invitation mutator.
+type InvitationFunc func(context.Context, *models.InvitationMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvitationFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.InvitationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.InvitationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The MembershipFunc type is an adapter to allow the use of ordinary
+// function as Membership mutator.
+type MembershipFunc func(context.Context, *models.MembershipMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MembershipFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.MembershipMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.MembershipMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SubscriptionFunc type is an adapter to allow the use of ordinary
+// function as Subscription mutator.
+type SubscriptionFunc func(context.Context, *models.SubscriptionMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.SubscriptionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.SubscriptionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *models.TagMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.TagMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TagMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *models.TaskMutation) (models.Value, error)
@@ -22,6 +126,71 @@ func (f TaskFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value,
 	return f(ctx, mv)
 }
 
+// The UsageFunc type is an adapter to allow the use of ordinary
+// function as Usage mutator.
+type UsageFunc func(context.Context, *models.UsageMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.UsageMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.UsageMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *models.WebhookDeliveryMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.WebhookDeliveryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WebhookDeliveryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WebhookEndpointFunc type is an adapter to allow the use of ordinary
+// function as WebhookEndpoint mutator.
+type WebhookEndpointFunc func(context.Context, *models.WebhookEndpointMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEndpointFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.WebhookEndpointMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WebhookEndpointMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WebhookEventFunc type is an adapter to allow the use of ordinary
+// function as WebhookEvent mutator.
+type WebhookEventFunc func(context.Context, *models.WebhookEventMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEventFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.WebhookEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WebhookEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WorkspaceFunc type is an adapter to allow the use of ordinary
+// function as Workspace mutator.
+type WorkspaceFunc func(context.Context, *models.WorkspaceMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkspaceFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	mv, ok := m.(*models.WorkspaceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WorkspaceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, models.Mutation) bool
 
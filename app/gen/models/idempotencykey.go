@@ -0,0 +1,179 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/idempotencykey"
+)
+
+// IdempotencyKey is the model entity for the IdempotencyKey schema.
+type IdempotencyKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// RequestHash holds the value of the "request_hash" field.
+	RequestHash string `json:"request_hash,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode int `json:"status_code,omitempty"`
+	// Headers holds the value of the "headers" field.
+	Headers http.Header `json:"headers,omitempty"`
+	// Response holds the value of the "response" field.
+	Response []byte `json:"response,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdempotencyKey) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldHeaders, idempotencykey.FieldResponse:
+			values[i] = &[]byte{}
+		case idempotencykey.FieldID, idempotencykey.FieldStatusCode:
+			values[i] = &sql.NullInt64{}
+		case idempotencykey.FieldOwner, idempotencykey.FieldKey, idempotencykey.FieldRequestHash:
+			values[i] = &sql.NullString{}
+		case idempotencykey.FieldCreatedAt, idempotencykey.FieldExpiresAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type IdempotencyKey", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdempotencyKey fields.
+func (ik *IdempotencyKey) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ik.ID = int(value.Int64)
+		case idempotencykey.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				ik.Owner = value.String
+			}
+		case idempotencykey.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ik.Key = value.String
+			}
+		case idempotencykey.FieldRequestHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_hash", values[i])
+			} else if value.Valid {
+				ik.RequestHash = value.String
+			}
+		case idempotencykey.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				ik.StatusCode = int(value.Int64)
+			}
+		case idempotencykey.FieldHeaders:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ik.Headers); err != nil {
+					return fmt.Errorf("unmarshal field headers: %w", err)
+				}
+			}
+		case idempotencykey.FieldResponse:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response", values[i])
+			} else if value != nil {
+				ik.Response = *value
+			}
+		case idempotencykey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ik.CreatedAt = value.Time
+			}
+		case idempotencykey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ik.ExpiresAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this IdempotencyKey.
+// Note that you need to call IdempotencyKey.Unwrap() before calling this method if this IdempotencyKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ik *IdempotencyKey) Update() *IdempotencyKeyUpdateOne {
+	return (&IdempotencyKeyClient{config: ik.config}).UpdateOne(ik)
+}
+
+// Unwrap unwraps the IdempotencyKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ik *IdempotencyKey) Unwrap() *IdempotencyKey {
+	tx, ok := ik.config.driver.(*txDriver)
+	if !ok {
+		panic("models: IdempotencyKey is not a transactional entity")
+	}
+	ik.config.driver = tx.drv
+	return ik
+}
+
+// String implements the fmt.Stringer.
+func (ik *IdempotencyKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdempotencyKey(")
+	builder.WriteString(fmt.Sprintf("id=%v", ik.ID))
+	builder.WriteString(", owner=")
+	builder.WriteString(ik.Owner)
+	builder.WriteString(", key=")
+	builder.WriteString(ik.Key)
+	builder.WriteString(", request_hash=")
+	builder.WriteString(ik.RequestHash)
+	builder.WriteString(", status_code=")
+	builder.WriteString(fmt.Sprintf("%v", ik.StatusCode))
+	builder.WriteString(", headers=")
+	builder.WriteString(fmt.Sprintf("%v", ik.Headers))
+	builder.WriteString(", response=")
+	builder.WriteString(fmt.Sprintf("%v", ik.Response))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ik.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(ik.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdempotencyKeys is a parsable slice of IdempotencyKey.
+type IdempotencyKeys []*IdempotencyKey
+
+func (ik IdempotencyKeys) config(cfg config) {
+	for _i := range ik {
+		ik[_i].config = cfg
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package idempotencykey
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the idempotencykey type in the database.
+	Label = "idempotency_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldRequestHash holds the string denoting the request_hash field in the database.
+	FieldRequestHash = "request_hash"
+	// FieldStatusCode holds the string denoting the status_code field in the database.
+	FieldStatusCode = "status_code"
+	// FieldHeaders holds the string denoting the headers field in the database.
+	FieldHeaders = "headers"
+	// FieldResponse holds the string denoting the response field in the database.
+	FieldResponse = "response"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the idempotencykey in the database.
+	Table = "idempotency_keys"
+)
+
+// Columns holds all SQL columns for idempotencykey fields.
+var Columns = []string{
+	FieldID,
+	FieldOwner,
+	FieldKey,
+	FieldRequestHash,
+	FieldStatusCode,
+	FieldHeaders,
+	FieldResponse,
+	FieldCreatedAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,853 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package idempotencykey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwner), v))
+	})
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKey), v))
+	})
+}
+
+// RequestHash applies equality check predicate on the "request_hash" field. It's identical to RequestHashEQ.
+func RequestHash(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestHash), v))
+	})
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatusCode), v))
+	})
+}
+
+// Response applies equality check predicate on the "response" field. It's identical to ResponseEQ.
+func Response(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponse), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOwner), v...))
+	})
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOwner), v...))
+	})
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOwner), v))
+	})
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOwner), v))
+	})
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKey), v))
+	})
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKey), v))
+	})
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKey), v...))
+	})
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKey), v...))
+	})
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKey), v))
+	})
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKey), v))
+	})
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKey), v))
+	})
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKey), v))
+	})
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKey), v))
+	})
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKey), v))
+	})
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKey), v))
+	})
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKey), v))
+	})
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKey), v))
+	})
+}
+
+// RequestHashEQ applies the EQ predicate on the "request_hash" field.
+func RequestHashEQ(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashNEQ applies the NEQ predicate on the "request_hash" field.
+func RequestHashNEQ(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashIn applies the In predicate on the "request_hash" field.
+func RequestHashIn(vs ...string) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRequestHash), v...))
+	})
+}
+
+// RequestHashNotIn applies the NotIn predicate on the "request_hash" field.
+func RequestHashNotIn(vs ...string) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRequestHash), v...))
+	})
+}
+
+// RequestHashGT applies the GT predicate on the "request_hash" field.
+func RequestHashGT(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashGTE applies the GTE predicate on the "request_hash" field.
+func RequestHashGTE(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashLT applies the LT predicate on the "request_hash" field.
+func RequestHashLT(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashLTE applies the LTE predicate on the "request_hash" field.
+func RequestHashLTE(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashContains applies the Contains predicate on the "request_hash" field.
+func RequestHashContains(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashHasPrefix applies the HasPrefix predicate on the "request_hash" field.
+func RequestHashHasPrefix(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashHasSuffix applies the HasSuffix predicate on the "request_hash" field.
+func RequestHashHasSuffix(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashEqualFold applies the EqualFold predicate on the "request_hash" field.
+func RequestHashEqualFold(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRequestHash), v))
+	})
+}
+
+// RequestHashContainsFold applies the ContainsFold predicate on the "request_hash" field.
+func RequestHashContainsFold(v string) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRequestHash), v))
+	})
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatusCode), v...))
+	})
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatusCode), v...))
+	})
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStatusCode)))
+	})
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStatusCode)))
+	})
+}
+
+// HeadersIsNil applies the IsNil predicate on the "headers" field.
+func HeadersIsNil() predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHeaders)))
+	})
+}
+
+// HeadersNotNil applies the NotNil predicate on the "headers" field.
+func HeadersNotNil() predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHeaders)))
+	})
+}
+
+// ResponseEQ applies the EQ predicate on the "response" field.
+func ResponseEQ(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponse), v))
+	})
+}
+
+// ResponseNEQ applies the NEQ predicate on the "response" field.
+func ResponseNEQ(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResponse), v))
+	})
+}
+
+// ResponseIn applies the In predicate on the "response" field.
+func ResponseIn(vs ...[]byte) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResponse), v...))
+	})
+}
+
+// ResponseNotIn applies the NotIn predicate on the "response" field.
+func ResponseNotIn(vs ...[]byte) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResponse), v...))
+	})
+}
+
+// ResponseGT applies the GT predicate on the "response" field.
+func ResponseGT(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResponse), v))
+	})
+}
+
+// ResponseGTE applies the GTE predicate on the "response" field.
+func ResponseGTE(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResponse), v))
+	})
+}
+
+// ResponseLT applies the LT predicate on the "response" field.
+func ResponseLT(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResponse), v))
+	})
+}
+
+// ResponseLTE applies the LTE predicate on the "response" field.
+func ResponseLTE(v []byte) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResponse), v))
+	})
+}
+
+// ResponseIsNil applies the IsNil predicate on the "response" field.
+func ResponseIsNil() predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResponse)))
+	})
+}
+
+// ResponseNotNil applies the NotNil predicate on the "response" field.
+func ResponseNotNil() predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResponse)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.IdempotencyKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IdempotencyKey) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IdempotencyKey) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IdempotencyKey) predicate.IdempotencyKey {
+	return predicate.IdempotencyKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
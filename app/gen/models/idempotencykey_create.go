@@ -0,0 +1,322 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/idempotencykey"
+)
+
+// IdempotencyKeyCreate is the builder for creating a IdempotencyKey entity.
+type IdempotencyKeyCreate struct {
+	config
+	mutation *IdempotencyKeyMutation
+	hooks    []Hook
+}
+
+// SetOwner sets the "owner" field.
+func (ikc *IdempotencyKeyCreate) SetOwner(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetOwner(s)
+	return ikc
+}
+
+// SetKey sets the "key" field.
+func (ikc *IdempotencyKeyCreate) SetKey(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetKey(s)
+	return ikc
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (ikc *IdempotencyKeyCreate) SetRequestHash(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetRequestHash(s)
+	return ikc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ikc *IdempotencyKeyCreate) SetStatusCode(i int) *IdempotencyKeyCreate {
+	ikc.mutation.SetStatusCode(i)
+	return ikc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableStatusCode(i *int) *IdempotencyKeyCreate {
+	if i != nil {
+		ikc.SetStatusCode(*i)
+	}
+	return ikc
+}
+
+// SetHeaders sets the "headers" field.
+func (ikc *IdempotencyKeyCreate) SetHeaders(h http.Header) *IdempotencyKeyCreate {
+	ikc.mutation.SetHeaders(h)
+	return ikc
+}
+
+// SetResponse sets the "response" field.
+func (ikc *IdempotencyKeyCreate) SetResponse(b []byte) *IdempotencyKeyCreate {
+	ikc.mutation.SetResponse(b)
+	return ikc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikc *IdempotencyKeyCreate) SetCreatedAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetCreatedAt(t)
+	return ikc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableCreatedAt(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetCreatedAt(*t)
+	}
+	return ikc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikc *IdempotencyKeyCreate) SetExpiresAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetExpiresAt(t)
+	return ikc
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikc *IdempotencyKeyCreate) Mutation() *IdempotencyKeyMutation {
+	return ikc.mutation
+}
+
+// Save creates the IdempotencyKey in the database.
+func (ikc *IdempotencyKeyCreate) Save(ctx context.Context) (*IdempotencyKey, error) {
+	var (
+		err  error
+		node *IdempotencyKey
+	)
+	ikc.defaults()
+	if len(ikc.hooks) == 0 {
+		if err = ikc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ikc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotencyKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ikc.check(); err != nil {
+				return nil, err
+			}
+			ikc.mutation = mutation
+			node, err = ikc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ikc.hooks) - 1; i >= 0; i-- {
+			mut = ikc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ikc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikc *IdempotencyKeyCreate) SaveX(ctx context.Context) *IdempotencyKey {
+	v, err := ikc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ikc *IdempotencyKeyCreate) defaults() {
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		v := idempotencykey.DefaultCreatedAt()
+		ikc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikc *IdempotencyKeyCreate) check() error {
+	if _, ok := ikc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New("models: missing required field \"owner\"")}
+	}
+	if _, ok := ikc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New("models: missing required field \"key\"")}
+	}
+	if _, ok := ikc.mutation.RequestHash(); !ok {
+		return &ValidationError{Name: "request_hash", err: errors.New("models: missing required field \"request_hash\"")}
+	}
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	if _, ok := ikc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New("models: missing required field \"expires_at\"")}
+	}
+	return nil
+}
+
+func (ikc *IdempotencyKeyCreate) sqlSave(ctx context.Context) (*IdempotencyKey, error) {
+	_node, _spec := ikc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ikc *IdempotencyKeyCreate) createSpec() (*IdempotencyKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdempotencyKey{config: ikc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: idempotencykey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		}
+	)
+	if value, ok := ikc.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := ikc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldKey,
+		})
+		_node.Key = value
+	}
+	if value, ok := ikc.mutation.RequestHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldRequestHash,
+		})
+		_node.RequestHash = value
+	}
+	if value, ok := ikc.mutation.StatusCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: idempotencykey.FieldStatusCode,
+		})
+		_node.StatusCode = value
+	}
+	if value, ok := ikc.mutation.Headers(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: idempotencykey.FieldHeaders,
+		})
+		_node.Headers = value
+	}
+	if value, ok := ikc.mutation.Response(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldResponse,
+		})
+		_node.Response = value
+	}
+	if value, ok := ikc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ikc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// IdempotencyKeyCreateBulk is the builder for creating many IdempotencyKey entities in bulk.
+type IdempotencyKeyCreateBulk struct {
+	config
+	builders []*IdempotencyKeyCreate
+}
+
+// Save creates the IdempotencyKey entities in the database.
+func (ikcb *IdempotencyKeyCreateBulk) Save(ctx context.Context) ([]*IdempotencyKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ikcb.builders))
+	nodes := make([]*IdempotencyKey, len(ikcb.builders))
+	mutators := make([]Mutator, len(ikcb.builders))
+	for i := range ikcb.builders {
+		func(i int, root context.Context) {
+			builder := ikcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdempotencyKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) SaveX(ctx context.Context) []*IdempotencyKey {
+	v, err := ikcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
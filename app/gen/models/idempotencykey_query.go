@@ -0,0 +1,895 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/idempotencykey"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// IdempotencyKeyQuery is the builder for querying IdempotencyKey entities.
+type IdempotencyKeyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.IdempotencyKey
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IdempotencyKeyQuery builder.
+func (ikq *IdempotencyKeyQuery) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyQuery {
+	ikq.predicates = append(ikq.predicates, ps...)
+	return ikq
+}
+
+// Limit adds a limit step to the query.
+func (ikq *IdempotencyKeyQuery) Limit(limit int) *IdempotencyKeyQuery {
+	ikq.limit = &limit
+	return ikq
+}
+
+// Offset adds an offset step to the query.
+func (ikq *IdempotencyKeyQuery) Offset(offset int) *IdempotencyKeyQuery {
+	ikq.offset = &offset
+	return ikq
+}
+
+// Order adds an order step to the query.
+func (ikq *IdempotencyKeyQuery) Order(o ...OrderFunc) *IdempotencyKeyQuery {
+	ikq.order = append(ikq.order, o...)
+	return ikq
+}
+
+// First returns the first IdempotencyKey entity from the query.
+// Returns a *NotFoundError when no IdempotencyKey was found.
+func (ikq *IdempotencyKeyQuery) First(ctx context.Context) (*IdempotencyKey, error) {
+	nodes, err := ikq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{idempotencykey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) FirstX(ctx context.Context) *IdempotencyKey {
+	node, err := ikq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IdempotencyKey ID from the query.
+// Returns a *NotFoundError when no IdempotencyKey ID was found.
+func (ikq *IdempotencyKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ikq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{idempotencykey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := ikq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IdempotencyKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one IdempotencyKey entity is not found.
+// Returns a *NotFoundError when no IdempotencyKey entities are found.
+func (ikq *IdempotencyKeyQuery) Only(ctx context.Context) (*IdempotencyKey, error) {
+	nodes, err := ikq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{idempotencykey.Label}
+	default:
+		return nil, &NotSingularError{idempotencykey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) OnlyX(ctx context.Context) *IdempotencyKey {
+	node, err := ikq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IdempotencyKey ID in the query.
+// Returns a *NotSingularError when exactly one IdempotencyKey ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ikq *IdempotencyKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ikq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = &NotSingularError{idempotencykey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ikq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IdempotencyKeys.
+func (ikq *IdempotencyKeyQuery) All(ctx context.Context) ([]*IdempotencyKey, error) {
+	if err := ikq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ikq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) AllX(ctx context.Context) []*IdempotencyKey {
+	nodes, err := ikq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IdempotencyKey IDs.
+func (ikq *IdempotencyKeyQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ikq.Select(idempotencykey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := ikq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ikq *IdempotencyKeyQuery) Count(ctx context.Context) (int, error) {
+	if err := ikq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ikq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) CountX(ctx context.Context) int {
+	count, err := ikq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ikq *IdempotencyKeyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ikq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ikq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ikq *IdempotencyKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := ikq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IdempotencyKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ikq *IdempotencyKeyQuery) Clone() *IdempotencyKeyQuery {
+	if ikq == nil {
+		return nil
+	}
+	return &IdempotencyKeyQuery{
+		config:     ikq.config,
+		limit:      ikq.limit,
+		offset:     ikq.offset,
+		order:      append([]OrderFunc{}, ikq.order...),
+		predicates: append([]predicate.IdempotencyKey{}, ikq.predicates...),
+		// clone intermediate query.
+		sql:  ikq.sql.Clone(),
+		path: ikq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IdempotencyKey.Query().
+//		GroupBy(idempotencykey.FieldOwner).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (ikq *IdempotencyKeyQuery) GroupBy(field string, fields ...string) *IdempotencyKeyGroupBy {
+	group := &IdempotencyKeyGroupBy{config: ikq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ikq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ikq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//	}
+//
+//	client.IdempotencyKey.Query().
+//		Select(idempotencykey.FieldOwner).
+//		Scan(ctx, &v)
+//
+func (ikq *IdempotencyKeyQuery) Select(field string, fields ...string) *IdempotencyKeySelect {
+	ikq.fields = append([]string{field}, fields...)
+	return &IdempotencyKeySelect{IdempotencyKeyQuery: ikq}
+}
+
+func (ikq *IdempotencyKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ikq.fields {
+		if !idempotencykey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if ikq.path != nil {
+		prev, err := ikq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ikq.sql = prev
+	}
+	return nil
+}
+
+func (ikq *IdempotencyKeyQuery) sqlAll(ctx context.Context) ([]*IdempotencyKey, error) {
+	var (
+		nodes = []*IdempotencyKey{}
+		_spec = ikq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &IdempotencyKey{config: ikq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ikq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ikq *IdempotencyKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ikq.querySpec()
+	return sqlgraph.CountNodes(ctx, ikq.driver, _spec)
+}
+
+func (ikq *IdempotencyKeyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ikq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ikq *IdempotencyKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotencykey.Table,
+			Columns: idempotencykey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		},
+		From:   ikq.sql,
+		Unique: true,
+	}
+	if fields := ikq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for i := range fields {
+			if fields[i] != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ikq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ikq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ikq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ikq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, idempotencykey.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ikq *IdempotencyKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ikq.driver.Dialect())
+	t1 := builder.Table(idempotencykey.Table)
+	selector := builder.Select(t1.Columns(idempotencykey.Columns...)...).From(t1)
+	if ikq.sql != nil {
+		selector = ikq.sql
+		selector.Select(selector.Columns(idempotencykey.Columns...)...)
+	}
+	for _, p := range ikq.predicates {
+		p(selector)
+	}
+	for _, p := range ikq.order {
+		p(selector, idempotencykey.ValidColumn)
+	}
+	if offset := ikq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ikq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IdempotencyKeyGroupBy is the group-by builder for IdempotencyKey entities.
+type IdempotencyKeyGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ikgb *IdempotencyKeyGroupBy) Aggregate(fns ...AggregateFunc) *IdempotencyKeyGroupBy {
+	ikgb.fns = append(ikgb.fns, fns...)
+	return ikgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ikgb *IdempotencyKeyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ikgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ikgb.sql = query
+	return ikgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ikgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ikgb.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeyGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ikgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ikgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ikgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeyGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) StringX(ctx context.Context) string {
+	v, err := ikgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ikgb.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeyGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ikgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ikgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ikgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeyGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) IntX(ctx context.Context) int {
+	v, err := ikgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ikgb.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeyGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ikgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ikgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ikgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeyGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ikgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ikgb.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeyGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ikgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ikgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ikgb *IdempotencyKeyGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ikgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeyGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ikgb *IdempotencyKeyGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ikgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ikgb *IdempotencyKeyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ikgb.fields {
+		if !idempotencykey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ikgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ikgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ikgb *IdempotencyKeyGroupBy) sqlQuery() *sql.Selector {
+	selector := ikgb.sql
+	columns := make([]string, 0, len(ikgb.fields)+len(ikgb.fns))
+	columns = append(columns, ikgb.fields...)
+	for _, fn := range ikgb.fns {
+		columns = append(columns, fn(selector, idempotencykey.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(ikgb.fields...)
+}
+
+// IdempotencyKeySelect is the builder for selecting fields of IdempotencyKey entities.
+type IdempotencyKeySelect struct {
+	*IdempotencyKeyQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iks *IdempotencyKeySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := iks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	iks.sql = iks.IdempotencyKeyQuery.sqlQuery(ctx)
+	return iks.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := iks.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(iks.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := iks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) StringsX(ctx context.Context) []string {
+	v, err := iks.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = iks.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) StringX(ctx context.Context) string {
+	v, err := iks.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(iks.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := iks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) IntsX(ctx context.Context) []int {
+	v, err := iks.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = iks.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) IntX(ctx context.Context) int {
+	v, err := iks.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(iks.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := iks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := iks.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = iks.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) Float64X(ctx context.Context) float64 {
+	v, err := iks.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(iks.fields) > 1 {
+		return nil, errors.New("models: IdempotencyKeySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := iks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) BoolsX(ctx context.Context) []bool {
+	v, err := iks.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (iks *IdempotencyKeySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = iks.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{idempotencykey.Label}
+	default:
+		err = fmt.Errorf("models: IdempotencyKeySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (iks *IdempotencyKeySelect) BoolX(ctx context.Context) bool {
+	v, err := iks.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (iks *IdempotencyKeySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := iks.sqlQuery().Query()
+	if err := iks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (iks *IdempotencyKeySelect) sqlQuery() sql.Querier {
+	selector := iks.sql
+	selector.Select(selector.Columns(iks.fields...)...)
+	return selector
+}
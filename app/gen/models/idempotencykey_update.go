@@ -0,0 +1,512 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/idempotencykey"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where adds a new predicate for the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.predicates = append(iku.mutation.predicates, ps...)
+	return iku
+}
+
+// SetOwner sets the "owner" field.
+func (iku *IdempotencyKeyUpdate) SetOwner(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetOwner(s)
+	return iku
+}
+
+// SetKey sets the "key" field.
+func (iku *IdempotencyKeyUpdate) SetKey(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetKey(s)
+	return iku
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (iku *IdempotencyKeyUpdate) SetRequestHash(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetRequestHash(s)
+	return iku
+}
+
+// SetStatusCode sets the "status_code" field.
+func (iku *IdempotencyKeyUpdate) SetStatusCode(i int) *IdempotencyKeyUpdate {
+	iku.mutation.ResetStatusCode()
+	iku.mutation.SetStatusCode(i)
+	return iku
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableStatusCode(i *int) *IdempotencyKeyUpdate {
+	if i != nil {
+		iku.SetStatusCode(*i)
+	}
+	return iku
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (iku *IdempotencyKeyUpdate) AddStatusCode(i int) *IdempotencyKeyUpdate {
+	iku.mutation.AddStatusCode(i)
+	return iku
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (iku *IdempotencyKeyUpdate) ClearStatusCode() *IdempotencyKeyUpdate {
+	iku.mutation.ClearStatusCode()
+	return iku
+}
+
+// SetHeaders sets the "headers" field.
+func (iku *IdempotencyKeyUpdate) SetHeaders(h http.Header) *IdempotencyKeyUpdate {
+	iku.mutation.SetHeaders(h)
+	return iku
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (iku *IdempotencyKeyUpdate) ClearHeaders() *IdempotencyKeyUpdate {
+	iku.mutation.ClearHeaders()
+	return iku
+}
+
+// SetResponse sets the "response" field.
+func (iku *IdempotencyKeyUpdate) SetResponse(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetResponse(b)
+	return iku
+}
+
+// ClearResponse clears the value of the "response" field.
+func (iku *IdempotencyKeyUpdate) ClearResponse() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponse()
+	return iku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetExpiresAt(t)
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iku.hooks) == 0 {
+		affected, err = iku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotencyKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iku.mutation = mutation
+			affected, err = iku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iku.hooks) - 1; i >= 0; i-- {
+			mut = iku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotencykey.Table,
+			Columns: idempotencykey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		},
+	}
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldOwner,
+		})
+	}
+	if value, ok := iku.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldKey,
+		})
+	}
+	if value, ok := iku.mutation.RequestHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldRequestHash,
+		})
+	}
+	if value, ok := iku.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: idempotencykey.FieldStatusCode,
+		})
+	}
+	if value, ok := iku.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: idempotencykey.FieldStatusCode,
+		})
+	}
+	if iku.mutation.StatusCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: idempotencykey.FieldStatusCode,
+		})
+	}
+	if value, ok := iku.mutation.Headers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: idempotencykey.FieldHeaders,
+		})
+	}
+	if iku.mutation.HeadersCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: idempotencykey.FieldHeaders,
+		})
+	}
+	if value, ok := iku.mutation.Response(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if iku.mutation.ResponseCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if value, ok := iku.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldExpiresAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetOwner sets the "owner" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetOwner(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetOwner(s)
+	return ikuo
+}
+
+// SetKey sets the "key" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetKey(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetKey(s)
+	return ikuo
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetRequestHash(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetRequestHash(s)
+	return ikuo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetStatusCode(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ResetStatusCode()
+	ikuo.mutation.SetStatusCode(i)
+	return ikuo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableStatusCode(i *int) *IdempotencyKeyUpdateOne {
+	if i != nil {
+		ikuo.SetStatusCode(*i)
+	}
+	return ikuo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) AddStatusCode(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.AddStatusCode(i)
+	return ikuo
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearStatusCode() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearStatusCode()
+	return ikuo
+}
+
+// SetHeaders sets the "headers" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetHeaders(h http.Header) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetHeaders(h)
+	return ikuo
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearHeaders() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearHeaders()
+	return ikuo
+}
+
+// SetResponse sets the "response" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponse(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResponse(b)
+	return ikuo
+}
+
+// ClearResponse clears the value of the "response" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponse() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponse()
+	return ikuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetExpiresAt(t)
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	var (
+		err  error
+		node *IdempotencyKey
+	)
+	if len(ikuo.hooks) == 0 {
+		node, err = ikuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotencyKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ikuo.mutation = mutation
+			node, err = ikuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ikuo.hooks) - 1; i >= 0; i-- {
+			mut = ikuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ikuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotencykey.Table,
+			Columns: idempotencykey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		},
+	}
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing IdempotencyKey.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldOwner,
+		})
+	}
+	if value, ok := ikuo.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldKey,
+		})
+	}
+	if value, ok := ikuo.mutation.RequestHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldRequestHash,
+		})
+	}
+	if value, ok := ikuo.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: idempotencykey.FieldStatusCode,
+		})
+	}
+	if value, ok := ikuo.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: idempotencykey.FieldStatusCode,
+		})
+	}
+	if ikuo.mutation.StatusCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: idempotencykey.FieldStatusCode,
+		})
+	}
+	if value, ok := ikuo.mutation.Headers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: idempotencykey.FieldHeaders,
+		})
+	}
+	if ikuo.mutation.HeadersCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: idempotencykey.FieldHeaders,
+		})
+	}
+	if value, ok := ikuo.mutation.Response(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if ikuo.mutation.ResponseCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if value, ok := ikuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldExpiresAt,
+		})
+	}
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
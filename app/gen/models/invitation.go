@@ -0,0 +1,214 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// Invitation is the model entity for the Invitation schema.
+type Invitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role invitation.Role `json:"role,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"-"`
+	// InvitedBy holds the value of the "invited_by" field.
+	InvitedBy string `json:"invited_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status invitation.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvitationQuery when eager-loading is set.
+	Edges                 InvitationEdges `json:"edges"`
+	workspace_invitations *string
+}
+
+// InvitationEdges holds the relations/edges for other nodes in the graph.
+type InvitationEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvitationEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.loadedTypes[0] {
+		if e.Workspace == nil {
+			// The edge workspace was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: workspace.Label}
+		}
+		return e.Workspace, nil
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invitation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitation.FieldID, invitation.FieldEmail, invitation.FieldRole, invitation.FieldNonce, invitation.FieldInvitedBy, invitation.FieldStatus:
+			values[i] = &sql.NullString{}
+		case invitation.FieldExpiresAt, invitation.FieldRespondedAt, invitation.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		case invitation.ForeignKeys[0]: // workspace_invitations
+			values[i] = &sql.NullString{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Invitation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invitation fields.
+func (i *Invitation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invitation.FieldID:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[j])
+			} else if value.Valid {
+				i.ID = value.String
+			}
+		case invitation.FieldEmail:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[j])
+			} else if value.Valid {
+				i.Email = value.String
+			}
+		case invitation.FieldRole:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[j])
+			} else if value.Valid {
+				i.Role = invitation.Role(value.String)
+			}
+		case invitation.FieldNonce:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[j])
+			} else if value.Valid {
+				i.Nonce = value.String
+			}
+		case invitation.FieldInvitedBy:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[j])
+			} else if value.Valid {
+				i.InvitedBy = value.String
+			}
+		case invitation.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invitation.Status(value.String)
+			}
+		case invitation.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = value.Time
+			}
+		case invitation.FieldRespondedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[j])
+			} else if value.Valid {
+				i.RespondedAt = new(time.Time)
+				*i.RespondedAt = value.Time
+			}
+		case invitation.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case invitation.ForeignKeys[0]:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_invitations", values[j])
+			} else if value.Valid {
+				i.workspace_invitations = new(string)
+				*i.workspace_invitations = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWorkspace queries the "workspace" edge of the Invitation entity.
+func (i *Invitation) QueryWorkspace() *WorkspaceQuery {
+	return (&InvitationClient{config: i.config}).QueryWorkspace(i)
+}
+
+// Update returns a builder for updating this Invitation.
+// Note that you need to call Invitation.Unwrap() before calling this method if this Invitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invitation) Update() *InvitationUpdateOne {
+	return (&InvitationClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invitation) Unwrap() *Invitation {
+	tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Invitation is not a transactional entity")
+	}
+	i.config.driver = tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invitation(")
+	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	builder.WriteString(", email=")
+	builder.WriteString(i.Email)
+	builder.WriteString(", role=")
+	builder.WriteString(fmt.Sprintf("%v", i.Role))
+	builder.WriteString(", nonce=<sensitive>")
+	builder.WriteString(", invited_by=")
+	builder.WriteString(i.InvitedBy)
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(i.ExpiresAt.Format(time.ANSIC))
+	if v := i.RespondedAt; v != nil {
+		builder.WriteString(", responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invitations is a parsable slice of Invitation.
+type Invitations []*Invitation
+
+func (i Invitations) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
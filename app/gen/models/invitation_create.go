@@ -0,0 +1,402 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// InvitationCreate is the builder for creating a Invitation entity.
+type InvitationCreate struct {
+	config
+	mutation *InvitationMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (ic *InvitationCreate) SetEmail(s string) *InvitationCreate {
+	ic.mutation.SetEmail(s)
+	return ic
+}
+
+// SetRole sets the "role" field.
+func (ic *InvitationCreate) SetRole(i invitation.Role) *InvitationCreate {
+	ic.mutation.SetRole(i)
+	return ic
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableRole(i *invitation.Role) *InvitationCreate {
+	if i != nil {
+		ic.SetRole(*i)
+	}
+	return ic
+}
+
+// SetNonce sets the "nonce" field.
+func (ic *InvitationCreate) SetNonce(s string) *InvitationCreate {
+	ic.mutation.SetNonce(s)
+	return ic
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (ic *InvitationCreate) SetInvitedBy(s string) *InvitationCreate {
+	ic.mutation.SetInvitedBy(s)
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvitationCreate) SetStatus(i invitation.Status) *InvitationCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableStatus(i *invitation.Status) *InvitationCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InvitationCreate) SetExpiresAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (ic *InvitationCreate) SetRespondedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetRespondedAt(t)
+	return ic
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableRespondedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetRespondedAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InvitationCreate) SetCreatedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableCreatedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *InvitationCreate) SetID(s string) *InvitationCreate {
+	ic.mutation.SetID(s)
+	return ic
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (ic *InvitationCreate) SetWorkspaceID(id string) *InvitationCreate {
+	ic.mutation.SetWorkspaceID(id)
+	return ic
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (ic *InvitationCreate) SetWorkspace(w *Workspace) *InvitationCreate {
+	return ic.SetWorkspaceID(w.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (ic *InvitationCreate) Mutation() *InvitationMutation {
+	return ic.mutation
+}
+
+// Save creates the Invitation in the database.
+func (ic *InvitationCreate) Save(ctx context.Context) (*Invitation, error) {
+	var (
+		err  error
+		node *Invitation
+	)
+	ic.defaults()
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvitationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			node, err = ic.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvitationCreate) SaveX(ctx context.Context) *Invitation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvitationCreate) defaults() {
+	if _, ok := ic.mutation.Role(); !ok {
+		v := invitation.DefaultRole
+		ic.mutation.SetRole(v)
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invitation.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invitation.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvitationCreate) check() error {
+	if _, ok := ic.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New("models: missing required field \"email\"")}
+	}
+	if _, ok := ic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New("models: missing required field \"role\"")}
+	}
+	if v, ok := ic.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("models: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := ic.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New("models: missing required field \"nonce\"")}
+	}
+	if _, ok := ic.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New("models: missing required field \"invited_by\"")}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New("models: missing required field \"status\"")}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("models: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := ic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New("models: missing required field \"expires_at\"")}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	if _, ok := ic.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace", err: errors.New("models: missing required edge \"workspace\"")}
+	}
+	return nil
+}
+
+func (ic *InvitationCreate) sqlSave(ctx context.Context) (*Invitation, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (ic *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invitation{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invitation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: invitation.FieldID,
+			},
+		}
+	)
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ic.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := ic.mutation.Role(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldRole,
+		})
+		_node.Role = value
+	}
+	if value, ok := ic.mutation.Nonce(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldNonce,
+		})
+		_node.Nonce = value
+	}
+	if value, ok := ic.mutation.InvitedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldInvitedBy,
+		})
+		_node.InvitedBy = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := ic.mutation.RespondedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldRespondedAt,
+		})
+		_node.RespondedAt = &value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.WorkspaceTable,
+			Columns: []string{invitation.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.workspace_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InvitationCreateBulk is the builder for creating many Invitation entities in bulk.
+type InvitationCreateBulk struct {
+	config
+	builders []*InvitationCreate
+}
+
+// Save creates the Invitation entities in the database.
+func (icb *InvitationCreateBulk) Save(ctx context.Context) ([]*Invitation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invitation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvitationCreateBulk) SaveX(ctx context.Context) []*Invitation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,971 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// InvitationQuery is the builder for querying Invitation entities.
+type InvitationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Invitation
+	// eager-loading edges.
+	withWorkspace *WorkspaceQuery
+	withFKs       bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvitationQuery builder.
+func (iq *InvitationQuery) Where(ps ...predicate.Invitation) *InvitationQuery {
+	iq.predicates = append(iq.predicates, ps...)
+	return iq
+}
+
+// Limit adds a limit step to the query.
+func (iq *InvitationQuery) Limit(limit int) *InvitationQuery {
+	iq.limit = &limit
+	return iq
+}
+
+// Offset adds an offset step to the query.
+func (iq *InvitationQuery) Offset(offset int) *InvitationQuery {
+	iq.offset = &offset
+	return iq
+}
+
+// Order adds an order step to the query.
+func (iq *InvitationQuery) Order(o ...OrderFunc) *InvitationQuery {
+	iq.order = append(iq.order, o...)
+	return iq
+}
+
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (iq *InvitationQuery) QueryWorkspace() *WorkspaceQuery {
+	query := &WorkspaceQuery{config: iq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.WorkspaceTable, invitation.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Invitation entity from the query.
+// Returns a *NotFoundError when no Invitation was found.
+func (iq *InvitationQuery) First(ctx context.Context) (*Invitation, error) {
+	nodes, err := iq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iq *InvitationQuery) FirstX(ctx context.Context) *Invitation {
+	node, err := iq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Invitation ID from the query.
+// Returns a *NotFoundError when no Invitation ID was found.
+func (iq *InvitationQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iq *InvitationQuery) FirstIDX(ctx context.Context) string {
+	id, err := iq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Invitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Invitation entity is not found.
+// Returns a *NotFoundError when no Invitation entities are found.
+func (iq *InvitationQuery) Only(ctx context.Context) (*Invitation, error) {
+	nodes, err := iq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invitation.Label}
+	default:
+		return nil, &NotSingularError{invitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iq *InvitationQuery) OnlyX(ctx context.Context) *Invitation {
+	node, err := iq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Invitation ID in the query.
+// Returns a *NotSingularError when exactly one Invitation ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (iq *InvitationQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = &NotSingularError{invitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iq *InvitationQuery) OnlyIDX(ctx context.Context) string {
+	id, err := iq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Invitations.
+func (iq *InvitationQuery) All(ctx context.Context) ([]*Invitation, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iq *InvitationQuery) AllX(ctx context.Context) []*Invitation {
+	nodes, err := iq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Invitation IDs.
+func (iq *InvitationQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := iq.Select(invitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iq *InvitationQuery) IDsX(ctx context.Context) []string {
+	ids, err := iq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iq *InvitationQuery) Count(ctx context.Context) (int, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iq *InvitationQuery) CountX(ctx context.Context) int {
+	count, err := iq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iq *InvitationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iq *InvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := iq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iq *InvitationQuery) Clone() *InvitationQuery {
+	if iq == nil {
+		return nil
+	}
+	return &InvitationQuery{
+		config:        iq.config,
+		limit:         iq.limit,
+		offset:        iq.offset,
+		order:         append([]OrderFunc{}, iq.order...),
+		predicates:    append([]predicate.Invitation{}, iq.predicates...),
+		withWorkspace: iq.withWorkspace.Clone(),
+		// clone intermediate query.
+		sql:  iq.sql.Clone(),
+		path: iq.path,
+	}
+}
+
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InvitationQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *InvitationQuery {
+	query := &WorkspaceQuery{config: iq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withWorkspace = query
+	return iq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Invitation.Query().
+//		GroupBy(invitation.FieldEmail).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (iq *InvitationQuery) GroupBy(field string, fields ...string) *InvitationGroupBy {
+	group := &InvitationGroupBy{config: iq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	client.Invitation.Query().
+//		Select(invitation.FieldEmail).
+//		Scan(ctx, &v)
+//
+func (iq *InvitationQuery) Select(field string, fields ...string) *InvitationSelect {
+	iq.fields = append([]string{field}, fields...)
+	return &InvitationSelect{InvitationQuery: iq}
+}
+
+func (iq *InvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iq.fields {
+		if !invitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if iq.path != nil {
+		prev, err := iq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iq.sql = prev
+	}
+	return nil
+}
+
+func (iq *InvitationQuery) sqlAll(ctx context.Context) ([]*Invitation, error) {
+	var (
+		nodes       = []*Invitation{}
+		withFKs     = iq.withFKs
+		_spec       = iq.querySpec()
+		loadedTypes = [1]bool{
+			iq.withWorkspace != nil,
+		}
+	)
+	if iq.withWorkspace != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Invitation{config: iq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := iq.withWorkspace; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*Invitation)
+		for i := range nodes {
+			fk := nodes[i].workspace_invitations
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(workspace.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "workspace_invitations" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Workspace = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (iq *InvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iq.querySpec()
+	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
+}
+
+func (iq *InvitationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iq *InvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invitation.Table,
+			Columns: invitation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: invitation.FieldID,
+			},
+		},
+		From:   iq.sql,
+		Unique: true,
+	}
+	if fields := iq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for i := range fields {
+			if fields[i] != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, invitation.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iq *InvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iq.driver.Dialect())
+	t1 := builder.Table(invitation.Table)
+	selector := builder.Select(t1.Columns(invitation.Columns...)...).From(t1)
+	if iq.sql != nil {
+		selector = iq.sql
+		selector.Select(selector.Columns(invitation.Columns...)...)
+	}
+	for _, p := range iq.predicates {
+		p(selector)
+	}
+	for _, p := range iq.order {
+		p(selector, invitation.ValidColumn)
+	}
+	if offset := iq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InvitationGroupBy is the group-by builder for Invitation entities.
+type InvitationGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (igb *InvitationGroupBy) Aggregate(fns ...AggregateFunc) *InvitationGroupBy {
+	igb.fns = append(igb.fns, fns...)
+	return igb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (igb *InvitationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := igb.path(ctx)
+	if err != nil {
+		return err
+	}
+	igb.sql = query
+	return igb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (igb *InvitationGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := igb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("models: InvitationGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (igb *InvitationGroupBy) StringsX(ctx context.Context) []string {
+	v, err := igb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = igb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (igb *InvitationGroupBy) StringX(ctx context.Context) string {
+	v, err := igb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("models: InvitationGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (igb *InvitationGroupBy) IntsX(ctx context.Context) []int {
+	v, err := igb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = igb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (igb *InvitationGroupBy) IntX(ctx context.Context) int {
+	v, err := igb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("models: InvitationGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (igb *InvitationGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := igb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = igb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (igb *InvitationGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := igb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("models: InvitationGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (igb *InvitationGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := igb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (igb *InvitationGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = igb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (igb *InvitationGroupBy) BoolX(ctx context.Context) bool {
+	v, err := igb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (igb *InvitationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range igb.fields {
+		if !invitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := igb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := igb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (igb *InvitationGroupBy) sqlQuery() *sql.Selector {
+	selector := igb.sql
+	columns := make([]string, 0, len(igb.fields)+len(igb.fns))
+	columns = append(columns, igb.fields...)
+	for _, fn := range igb.fns {
+		columns = append(columns, fn(selector, invitation.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(igb.fields...)
+}
+
+// InvitationSelect is the builder for selecting fields of Invitation entities.
+type InvitationSelect struct {
+	*InvitationQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (is *InvitationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := is.prepareQuery(ctx); err != nil {
+		return err
+	}
+	is.sql = is.InvitationQuery.sqlQuery(ctx)
+	return is.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (is *InvitationSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := is.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("models: InvitationSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (is *InvitationSelect) StringsX(ctx context.Context) []string {
+	v, err := is.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = is.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (is *InvitationSelect) StringX(ctx context.Context) string {
+	v, err := is.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("models: InvitationSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (is *InvitationSelect) IntsX(ctx context.Context) []int {
+	v, err := is.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = is.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (is *InvitationSelect) IntX(ctx context.Context) int {
+	v, err := is.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("models: InvitationSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (is *InvitationSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := is.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = is.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (is *InvitationSelect) Float64X(ctx context.Context) float64 {
+	v, err := is.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("models: InvitationSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (is *InvitationSelect) BoolsX(ctx context.Context) []bool {
+	v, err := is.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (is *InvitationSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = is.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invitation.Label}
+	default:
+		err = fmt.Errorf("models: InvitationSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (is *InvitationSelect) BoolX(ctx context.Context) bool {
+	v, err := is.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (is *InvitationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := is.sqlQuery().Query()
+	if err := is.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (is *InvitationSelect) sqlQuery() sql.Querier {
+	selector := is.sql
+	selector.Select(selector.Columns(is.fields...)...)
+	return selector
+}
@@ -0,0 +1,621 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where adds a new predicate for the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.predicates = append(iu.mutation.predicates, ps...)
+	return iu
+}
+
+// SetEmail sets the "email" field.
+func (iu *InvitationUpdate) SetEmail(s string) *InvitationUpdate {
+	iu.mutation.SetEmail(s)
+	return iu
+}
+
+// SetRole sets the "role" field.
+func (iu *InvitationUpdate) SetRole(i invitation.Role) *InvitationUpdate {
+	iu.mutation.SetRole(i)
+	return iu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRole(i *invitation.Role) *InvitationUpdate {
+	if i != nil {
+		iu.SetRole(*i)
+	}
+	return iu
+}
+
+// SetNonce sets the "nonce" field.
+func (iu *InvitationUpdate) SetNonce(s string) *InvitationUpdate {
+	iu.mutation.SetNonce(s)
+	return iu
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (iu *InvitationUpdate) SetInvitedBy(s string) *InvitationUpdate {
+	iu.mutation.SetInvitedBy(s)
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvitationUpdate) SetStatus(i invitation.Status) *InvitationUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableStatus(i *invitation.Status) *InvitationUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (iu *InvitationUpdate) SetRespondedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetRespondedAt(t)
+	return iu
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRespondedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetRespondedAt(*t)
+	}
+	return iu
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (iu *InvitationUpdate) ClearRespondedAt() *InvitationUpdate {
+	iu.mutation.ClearRespondedAt()
+	return iu
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (iu *InvitationUpdate) SetWorkspaceID(id string) *InvitationUpdate {
+	iu.mutation.SetWorkspaceID(id)
+	return iu
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (iu *InvitationUpdate) SetWorkspace(w *Workspace) *InvitationUpdate {
+	return iu.SetWorkspaceID(w.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (iu *InvitationUpdate) ClearWorkspace() *InvitationUpdate {
+	iu.mutation.ClearWorkspace()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iu.hooks) == 0 {
+		if err = iu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvitationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iu.check(); err != nil {
+				return 0, err
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationUpdate) check() error {
+	if v, ok := iu.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("models: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("models: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := iu.mutation.WorkspaceID(); iu.mutation.WorkspaceCleared() && !ok {
+		return errors.New("models: clearing a required unique edge \"workspace\"")
+	}
+	return nil
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invitation.Table,
+			Columns: invitation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: invitation.FieldID,
+			},
+		},
+	}
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldEmail,
+		})
+	}
+	if value, ok := iu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldRole,
+		})
+	}
+	if value, ok := iu.mutation.Nonce(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldNonce,
+		})
+	}
+	if value, ok := iu.mutation.InvitedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldInvitedBy,
+		})
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldStatus,
+		})
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldExpiresAt,
+		})
+	}
+	if value, ok := iu.mutation.RespondedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldRespondedAt,
+		})
+	}
+	if iu.mutation.RespondedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: invitation.FieldRespondedAt,
+		})
+	}
+	if iu.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.WorkspaceTable,
+			Columns: []string{invitation.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.WorkspaceTable,
+			Columns: []string{invitation.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetEmail sets the "email" field.
+func (iuo *InvitationUpdateOne) SetEmail(s string) *InvitationUpdateOne {
+	iuo.mutation.SetEmail(s)
+	return iuo
+}
+
+// SetRole sets the "role" field.
+func (iuo *InvitationUpdateOne) SetRole(i invitation.Role) *InvitationUpdateOne {
+	iuo.mutation.SetRole(i)
+	return iuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRole(i *invitation.Role) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetRole(*i)
+	}
+	return iuo
+}
+
+// SetNonce sets the "nonce" field.
+func (iuo *InvitationUpdateOne) SetNonce(s string) *InvitationUpdateOne {
+	iuo.mutation.SetNonce(s)
+	return iuo
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (iuo *InvitationUpdateOne) SetInvitedBy(s string) *InvitationUpdateOne {
+	iuo.mutation.SetInvitedBy(s)
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvitationUpdateOne) SetStatus(i invitation.Status) *InvitationUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableStatus(i *invitation.Status) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (iuo *InvitationUpdateOne) SetRespondedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetRespondedAt(t)
+	return iuo
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRespondedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetRespondedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (iuo *InvitationUpdateOne) ClearRespondedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearRespondedAt()
+	return iuo
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (iuo *InvitationUpdateOne) SetWorkspaceID(id string) *InvitationUpdateOne {
+	iuo.mutation.SetWorkspaceID(id)
+	return iuo
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (iuo *InvitationUpdateOne) SetWorkspace(w *Workspace) *InvitationUpdateOne {
+	return iuo.SetWorkspaceID(w.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (iuo *InvitationUpdateOne) ClearWorkspace() *InvitationUpdateOne {
+	iuo.mutation.ClearWorkspace()
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	var (
+		err  error
+		node *Invitation
+	)
+	if len(iuo.hooks) == 0 {
+		if err = iuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvitationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iuo.check(); err != nil {
+				return nil, err
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			mut = iuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationUpdateOne) check() error {
+	if v, ok := iuo.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("models: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("models: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := iuo.mutation.WorkspaceID(); iuo.mutation.WorkspaceCleared() && !ok {
+		return errors.New("models: clearing a required unique edge \"workspace\"")
+	}
+	return nil
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invitation.Table,
+			Columns: invitation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: invitation.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Invitation.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldEmail,
+		})
+	}
+	if value, ok := iuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldRole,
+		})
+	}
+	if value, ok := iuo.mutation.Nonce(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldNonce,
+		})
+	}
+	if value, ok := iuo.mutation.InvitedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invitation.FieldInvitedBy,
+		})
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invitation.FieldStatus,
+		})
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldExpiresAt,
+		})
+	}
+	if value, ok := iuo.mutation.RespondedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invitation.FieldRespondedAt,
+		})
+	}
+	if iuo.mutation.RespondedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: invitation.FieldRespondedAt,
+		})
+	}
+	if iuo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.WorkspaceTable,
+			Columns: []string{invitation.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.WorkspaceTable,
+			Columns: []string{invitation.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
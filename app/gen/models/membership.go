@@ -0,0 +1,188 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// Membership is the model entity for the Membership schema.
+type Membership struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role membership.Role `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MembershipQuery when eager-loading is set.
+	Edges                 MembershipEdges `json:"edges"`
+	workspace_memberships *string
+}
+
+// MembershipEdges holds the relations/edges for other nodes in the graph.
+type MembershipEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MembershipEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.loadedTypes[0] {
+		if e.Workspace == nil {
+			// The edge workspace was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: workspace.Label}
+		}
+		return e.Workspace, nil
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e MembershipEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[1] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Membership) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case membership.FieldID, membership.FieldAccountID, membership.FieldEmail, membership.FieldRole:
+			values[i] = &sql.NullString{}
+		case membership.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		case membership.ForeignKeys[0]: // workspace_memberships
+			values[i] = &sql.NullString{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Membership", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Membership fields.
+func (m *Membership) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case membership.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				m.ID = value.String
+			}
+		case membership.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				m.AccountID = value.String
+			}
+		case membership.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				m.Email = value.String
+			}
+		case membership.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				m.Role = membership.Role(value.String)
+			}
+		case membership.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case membership.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_memberships", values[i])
+			} else if value.Valid {
+				m.workspace_memberships = new(string)
+				*m.workspace_memberships = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWorkspace queries the "workspace" edge of the Membership entity.
+func (m *Membership) QueryWorkspace() *WorkspaceQuery {
+	return (&MembershipClient{config: m.config}).QueryWorkspace(m)
+}
+
+// QueryGroups queries the "groups" edge of the Membership entity.
+func (m *Membership) QueryGroups() *GroupQuery {
+	return (&MembershipClient{config: m.config}).QueryGroups(m)
+}
+
+// Update returns a builder for updating this Membership.
+// Note that you need to call Membership.Unwrap() before calling this method if this Membership
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Membership) Update() *MembershipUpdateOne {
+	return (&MembershipClient{config: m.config}).UpdateOne(m)
+}
+
+// Unwrap unwraps the Membership entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Membership) Unwrap() *Membership {
+	tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Membership is not a transactional entity")
+	}
+	m.config.driver = tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Membership) String() string {
+	var builder strings.Builder
+	builder.WriteString("Membership(")
+	builder.WriteString(fmt.Sprintf("id=%v", m.ID))
+	builder.WriteString(", account_id=")
+	builder.WriteString(m.AccountID)
+	builder.WriteString(", email=")
+	builder.WriteString(m.Email)
+	builder.WriteString(", role=")
+	builder.WriteString(fmt.Sprintf("%v", m.Role))
+	builder.WriteString(", created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Memberships is a parsable slice of Membership.
+type Memberships []*Membership
+
+func (m Memberships) config(cfg config) {
+	for _i := range m {
+		m[_i].config = cfg
+	}
+}
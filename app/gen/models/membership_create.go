@@ -0,0 +1,347 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// MembershipCreate is the builder for creating a Membership entity.
+type MembershipCreate struct {
+	config
+	mutation *MembershipMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (mc *MembershipCreate) SetAccountID(s string) *MembershipCreate {
+	mc.mutation.SetAccountID(s)
+	return mc
+}
+
+// SetEmail sets the "email" field.
+func (mc *MembershipCreate) SetEmail(s string) *MembershipCreate {
+	mc.mutation.SetEmail(s)
+	return mc
+}
+
+// SetRole sets the "role" field.
+func (mc *MembershipCreate) SetRole(m membership.Role) *MembershipCreate {
+	mc.mutation.SetRole(m)
+	return mc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mc *MembershipCreate) SetNillableRole(m *membership.Role) *MembershipCreate {
+	if m != nil {
+		mc.SetRole(*m)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MembershipCreate) SetCreatedAt(t time.Time) *MembershipCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MembershipCreate) SetNillableCreatedAt(t *time.Time) *MembershipCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *MembershipCreate) SetID(s string) *MembershipCreate {
+	mc.mutation.SetID(s)
+	return mc
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (mc *MembershipCreate) SetWorkspaceID(id string) *MembershipCreate {
+	mc.mutation.SetWorkspaceID(id)
+	return mc
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (mc *MembershipCreate) SetWorkspace(w *Workspace) *MembershipCreate {
+	return mc.SetWorkspaceID(w.ID)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (mc *MembershipCreate) AddGroupIDs(ids ...string) *MembershipCreate {
+	mc.mutation.AddGroupIDs(ids...)
+	return mc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (mc *MembershipCreate) AddGroups(g ...*Group) *MembershipCreate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return mc.AddGroupIDs(ids...)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (mc *MembershipCreate) Mutation() *MembershipMutation {
+	return mc.mutation
+}
+
+// Save creates the Membership in the database.
+func (mc *MembershipCreate) Save(ctx context.Context) (*Membership, error) {
+	var (
+		err  error
+		node *Membership
+	)
+	mc.defaults()
+	if len(mc.hooks) == 0 {
+		if err = mc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mc.check(); err != nil {
+				return nil, err
+			}
+			mc.mutation = mutation
+			node, err = mc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mc.hooks) - 1; i >= 0; i-- {
+			mut = mc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MembershipCreate) SaveX(ctx context.Context) *Membership {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MembershipCreate) defaults() {
+	if _, ok := mc.mutation.Role(); !ok {
+		v := membership.DefaultRole
+		mc.mutation.SetRole(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := membership.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MembershipCreate) check() error {
+	if _, ok := mc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New("models: missing required field \"account_id\"")}
+	}
+	if _, ok := mc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New("models: missing required field \"email\"")}
+	}
+	if _, ok := mc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New("models: missing required field \"role\"")}
+	}
+	if v, ok := mc.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("models: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	if _, ok := mc.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace", err: errors.New("models: missing required edge \"workspace\"")}
+	}
+	return nil
+}
+
+func (mc *MembershipCreate) sqlSave(ctx context.Context) (*Membership, error) {
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (mc *MembershipCreate) createSpec() (*Membership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Membership{config: mc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: membership.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: membership.FieldID,
+			},
+		}
+	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.AccountID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: membership.FieldAccountID,
+		})
+		_node.AccountID = value
+	}
+	if value, ok := mc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: membership.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := mc.mutation.Role(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: membership.FieldRole,
+		})
+		_node.Role = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: membership.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := mc.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.WorkspaceTable,
+			Columns: []string{membership.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.workspace_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MembershipCreateBulk is the builder for creating many Membership entities in bulk.
+type MembershipCreateBulk struct {
+	config
+	builders []*MembershipCreate
+}
+
+// Save creates the Membership entities in the database.
+func (mcb *MembershipCreateBulk) Save(ctx context.Context) ([]*Membership, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Membership, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MembershipCreateBulk) SaveX(ctx context.Context) []*Membership {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
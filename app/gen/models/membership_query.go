@@ -0,0 +1,1073 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// MembershipQuery is the builder for querying Membership entities.
+type MembershipQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Membership
+	// eager-loading edges.
+	withWorkspace *WorkspaceQuery
+	withGroups    *GroupQuery
+	withFKs       bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MembershipQuery builder.
+func (mq *MembershipQuery) Where(ps ...predicate.Membership) *MembershipQuery {
+	mq.predicates = append(mq.predicates, ps...)
+	return mq
+}
+
+// Limit adds a limit step to the query.
+func (mq *MembershipQuery) Limit(limit int) *MembershipQuery {
+	mq.limit = &limit
+	return mq
+}
+
+// Offset adds an offset step to the query.
+func (mq *MembershipQuery) Offset(offset int) *MembershipQuery {
+	mq.offset = &offset
+	return mq
+}
+
+// Order adds an order step to the query.
+func (mq *MembershipQuery) Order(o ...OrderFunc) *MembershipQuery {
+	mq.order = append(mq.order, o...)
+	return mq
+}
+
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (mq *MembershipQuery) QueryWorkspace() *WorkspaceQuery {
+	query := &WorkspaceQuery{config: mq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(membership.Table, membership.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, membership.WorkspaceTable, membership.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroups chains the current query on the "groups" edge.
+func (mq *MembershipQuery) QueryGroups() *GroupQuery {
+	query := &GroupQuery{config: mq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(membership.Table, membership.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, membership.GroupsTable, membership.GroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Membership entity from the query.
+// Returns a *NotFoundError when no Membership was found.
+func (mq *MembershipQuery) First(ctx context.Context) (*Membership, error) {
+	nodes, err := mq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{membership.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mq *MembershipQuery) FirstX(ctx context.Context) *Membership {
+	node, err := mq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Membership ID from the query.
+// Returns a *NotFoundError when no Membership ID was found.
+func (mq *MembershipQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{membership.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mq *MembershipQuery) FirstIDX(ctx context.Context) string {
+	id, err := mq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Membership entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Membership entity is not found.
+// Returns a *NotFoundError when no Membership entities are found.
+func (mq *MembershipQuery) Only(ctx context.Context) (*Membership, error) {
+	nodes, err := mq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{membership.Label}
+	default:
+		return nil, &NotSingularError{membership.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mq *MembershipQuery) OnlyX(ctx context.Context) *Membership {
+	node, err := mq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Membership ID in the query.
+// Returns a *NotSingularError when exactly one Membership ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (mq *MembershipQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = &NotSingularError{membership.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mq *MembershipQuery) OnlyIDX(ctx context.Context) string {
+	id, err := mq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Memberships.
+func (mq *MembershipQuery) All(ctx context.Context) ([]*Membership, error) {
+	if err := mq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mq *MembershipQuery) AllX(ctx context.Context) []*Membership {
+	nodes, err := mq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Membership IDs.
+func (mq *MembershipQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := mq.Select(membership.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mq *MembershipQuery) IDsX(ctx context.Context) []string {
+	ids, err := mq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mq *MembershipQuery) Count(ctx context.Context) (int, error) {
+	if err := mq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mq *MembershipQuery) CountX(ctx context.Context) int {
+	count, err := mq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mq *MembershipQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mq *MembershipQuery) ExistX(ctx context.Context) bool {
+	exist, err := mq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MembershipQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mq *MembershipQuery) Clone() *MembershipQuery {
+	if mq == nil {
+		return nil
+	}
+	return &MembershipQuery{
+		config:        mq.config,
+		limit:         mq.limit,
+		offset:        mq.offset,
+		order:         append([]OrderFunc{}, mq.order...),
+		predicates:    append([]predicate.Membership{}, mq.predicates...),
+		withWorkspace: mq.withWorkspace.Clone(),
+		withGroups:    mq.withGroups.Clone(),
+		// clone intermediate query.
+		sql:  mq.sql.Clone(),
+		path: mq.path,
+	}
+}
+
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MembershipQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *MembershipQuery {
+	query := &WorkspaceQuery{config: mq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withWorkspace = query
+	return mq
+}
+
+// WithGroups tells the query-builder to eager-load the nodes that are connected to
+// the "groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MembershipQuery) WithGroups(opts ...func(*GroupQuery)) *MembershipQuery {
+	query := &GroupQuery{config: mq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withGroups = query
+	return mq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID string `json:"account_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Membership.Query().
+//		GroupBy(membership.FieldAccountID).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (mq *MembershipQuery) GroupBy(field string, fields ...string) *MembershipGroupBy {
+	group := &MembershipGroupBy{config: mq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID string `json:"account_id,omitempty"`
+//	}
+//
+//	client.Membership.Query().
+//		Select(membership.FieldAccountID).
+//		Scan(ctx, &v)
+//
+func (mq *MembershipQuery) Select(field string, fields ...string) *MembershipSelect {
+	mq.fields = append([]string{field}, fields...)
+	return &MembershipSelect{MembershipQuery: mq}
+}
+
+func (mq *MembershipQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mq.fields {
+		if !membership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if mq.path != nil {
+		prev, err := mq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mq.sql = prev
+	}
+	return nil
+}
+
+func (mq *MembershipQuery) sqlAll(ctx context.Context) ([]*Membership, error) {
+	var (
+		nodes       = []*Membership{}
+		withFKs     = mq.withFKs
+		_spec       = mq.querySpec()
+		loadedTypes = [2]bool{
+			mq.withWorkspace != nil,
+			mq.withGroups != nil,
+		}
+	)
+	if mq.withWorkspace != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, membership.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Membership{config: mq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := mq.withWorkspace; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*Membership)
+		for i := range nodes {
+			fk := nodes[i].workspace_memberships
+			if fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(workspace.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "workspace_memberships" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Workspace = n
+			}
+		}
+	}
+
+	if query := mq.withGroups; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[string]*Membership, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.Groups = []*Group{}
+		}
+		var (
+			edgeids []string
+			edges   = make(map[string][]*Membership)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   membership.GroupsTable,
+				Columns: membership.GroupsPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(membership.GroupsPrimaryKey[1], fks...))
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullString{}, &sql.NullString{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullString)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullString)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := eout.String
+				inValue := ein.String
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				edgeids = append(edgeids, inValue)
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, mq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "groups": %w`, err)
+		}
+		query.Where(group.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Groups = append(nodes[i].Edges.Groups, n)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (mq *MembershipQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mq.querySpec()
+	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
+}
+
+func (mq *MembershipQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := mq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (mq *MembershipQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   membership.Table,
+			Columns: membership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: membership.FieldID,
+			},
+		},
+		From:   mq.sql,
+		Unique: true,
+	}
+	if fields := mq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, membership.FieldID)
+		for i := range fields {
+			if fields[i] != membership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, membership.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mq *MembershipQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mq.driver.Dialect())
+	t1 := builder.Table(membership.Table)
+	selector := builder.Select(t1.Columns(membership.Columns...)...).From(t1)
+	if mq.sql != nil {
+		selector = mq.sql
+		selector.Select(selector.Columns(membership.Columns...)...)
+	}
+	for _, p := range mq.predicates {
+		p(selector)
+	}
+	for _, p := range mq.order {
+		p(selector, membership.ValidColumn)
+	}
+	if offset := mq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MembershipGroupBy is the group-by builder for Membership entities.
+type MembershipGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mgb *MembershipGroupBy) Aggregate(fns ...AggregateFunc) *MembershipGroupBy {
+	mgb.fns = append(mgb.fns, fns...)
+	return mgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mgb *MembershipGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := mgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mgb.sql = query
+	return mgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mgb *MembershipGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := mgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("models: MembershipGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mgb *MembershipGroupBy) StringsX(ctx context.Context) []string {
+	v, err := mgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mgb *MembershipGroupBy) StringX(ctx context.Context) string {
+	v, err := mgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("models: MembershipGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mgb *MembershipGroupBy) IntsX(ctx context.Context) []int {
+	v, err := mgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mgb *MembershipGroupBy) IntX(ctx context.Context) int {
+	v, err := mgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("models: MembershipGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mgb *MembershipGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := mgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mgb *MembershipGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := mgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("models: MembershipGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mgb *MembershipGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := mgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MembershipGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mgb *MembershipGroupBy) BoolX(ctx context.Context) bool {
+	v, err := mgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mgb *MembershipGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range mgb.fields {
+		if !membership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mgb *MembershipGroupBy) sqlQuery() *sql.Selector {
+	selector := mgb.sql
+	columns := make([]string, 0, len(mgb.fields)+len(mgb.fns))
+	columns = append(columns, mgb.fields...)
+	for _, fn := range mgb.fns {
+		columns = append(columns, fn(selector, membership.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(mgb.fields...)
+}
+
+// MembershipSelect is the builder for selecting fields of Membership entities.
+type MembershipSelect struct {
+	*MembershipQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ms *MembershipSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ms.sql = ms.MembershipQuery.sqlQuery(ctx)
+	return ms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ms *MembershipSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("models: MembershipSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ms *MembershipSelect) StringsX(ctx context.Context) []string {
+	v, err := ms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ms *MembershipSelect) StringX(ctx context.Context) string {
+	v, err := ms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("models: MembershipSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ms *MembershipSelect) IntsX(ctx context.Context) []int {
+	v, err := ms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ms *MembershipSelect) IntX(ctx context.Context) int {
+	v, err := ms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("models: MembershipSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ms *MembershipSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ms *MembershipSelect) Float64X(ctx context.Context) float64 {
+	v, err := ms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("models: MembershipSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ms *MembershipSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ms *MembershipSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{membership.Label}
+	default:
+		err = fmt.Errorf("models: MembershipSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ms *MembershipSelect) BoolX(ctx context.Context) bool {
+	v, err := ms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ms *MembershipSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ms.sqlQuery().Query()
+	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ms *MembershipSelect) sqlQuery() sql.Querier {
+	selector := ms.sql
+	selector.Select(selector.Columns(ms.fields...)...)
+	return selector
+}
@@ -0,0 +1,631 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// MembershipUpdate is the builder for updating Membership entities.
+type MembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MembershipMutation
+}
+
+// Where adds a new predicate for the MembershipUpdate builder.
+func (mu *MembershipUpdate) Where(ps ...predicate.Membership) *MembershipUpdate {
+	mu.mutation.predicates = append(mu.mutation.predicates, ps...)
+	return mu
+}
+
+// SetAccountID sets the "account_id" field.
+func (mu *MembershipUpdate) SetAccountID(s string) *MembershipUpdate {
+	mu.mutation.SetAccountID(s)
+	return mu
+}
+
+// SetEmail sets the "email" field.
+func (mu *MembershipUpdate) SetEmail(s string) *MembershipUpdate {
+	mu.mutation.SetEmail(s)
+	return mu
+}
+
+// SetRole sets the "role" field.
+func (mu *MembershipUpdate) SetRole(m membership.Role) *MembershipUpdate {
+	mu.mutation.SetRole(m)
+	return mu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mu *MembershipUpdate) SetNillableRole(m *membership.Role) *MembershipUpdate {
+	if m != nil {
+		mu.SetRole(*m)
+	}
+	return mu
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (mu *MembershipUpdate) SetWorkspaceID(id string) *MembershipUpdate {
+	mu.mutation.SetWorkspaceID(id)
+	return mu
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (mu *MembershipUpdate) SetWorkspace(w *Workspace) *MembershipUpdate {
+	return mu.SetWorkspaceID(w.ID)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (mu *MembershipUpdate) AddGroupIDs(ids ...string) *MembershipUpdate {
+	mu.mutation.AddGroupIDs(ids...)
+	return mu
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (mu *MembershipUpdate) AddGroups(g ...*Group) *MembershipUpdate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return mu.AddGroupIDs(ids...)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (mu *MembershipUpdate) Mutation() *MembershipMutation {
+	return mu.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (mu *MembershipUpdate) ClearWorkspace() *MembershipUpdate {
+	mu.mutation.ClearWorkspace()
+	return mu
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (mu *MembershipUpdate) ClearGroups() *MembershipUpdate {
+	mu.mutation.ClearGroups()
+	return mu
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (mu *MembershipUpdate) RemoveGroupIDs(ids ...string) *MembershipUpdate {
+	mu.mutation.RemoveGroupIDs(ids...)
+	return mu
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (mu *MembershipUpdate) RemoveGroups(g ...*Group) *MembershipUpdate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return mu.RemoveGroupIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MembershipUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mu.hooks) == 0 {
+		if err = mu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = mu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mu.check(); err != nil {
+				return 0, err
+			}
+			mu.mutation = mutation
+			affected, err = mu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mu.hooks) - 1; i >= 0; i-- {
+			mut = mu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MembershipUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MembershipUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MembershipUpdate) check() error {
+	if v, ok := mu.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("models: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := mu.mutation.WorkspaceID(); mu.mutation.WorkspaceCleared() && !ok {
+		return errors.New("models: clearing a required unique edge \"workspace\"")
+	}
+	return nil
+}
+
+func (mu *MembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   membership.Table,
+			Columns: membership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: membership.FieldID,
+			},
+		},
+	}
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.AccountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: membership.FieldAccountID,
+		})
+	}
+	if value, ok := mu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: membership.FieldEmail,
+		})
+	}
+	if value, ok := mu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: membership.FieldRole,
+		})
+	}
+	if mu.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.WorkspaceTable,
+			Columns: []string{membership.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.WorkspaceTable,
+			Columns: []string{membership.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !mu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MembershipUpdateOne is the builder for updating a single Membership entity.
+type MembershipUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *MembershipMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (muo *MembershipUpdateOne) SetAccountID(s string) *MembershipUpdateOne {
+	muo.mutation.SetAccountID(s)
+	return muo
+}
+
+// SetEmail sets the "email" field.
+func (muo *MembershipUpdateOne) SetEmail(s string) *MembershipUpdateOne {
+	muo.mutation.SetEmail(s)
+	return muo
+}
+
+// SetRole sets the "role" field.
+func (muo *MembershipUpdateOne) SetRole(m membership.Role) *MembershipUpdateOne {
+	muo.mutation.SetRole(m)
+	return muo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (muo *MembershipUpdateOne) SetNillableRole(m *membership.Role) *MembershipUpdateOne {
+	if m != nil {
+		muo.SetRole(*m)
+	}
+	return muo
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (muo *MembershipUpdateOne) SetWorkspaceID(id string) *MembershipUpdateOne {
+	muo.mutation.SetWorkspaceID(id)
+	return muo
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (muo *MembershipUpdateOne) SetWorkspace(w *Workspace) *MembershipUpdateOne {
+	return muo.SetWorkspaceID(w.ID)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (muo *MembershipUpdateOne) AddGroupIDs(ids ...string) *MembershipUpdateOne {
+	muo.mutation.AddGroupIDs(ids...)
+	return muo
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (muo *MembershipUpdateOne) AddGroups(g ...*Group) *MembershipUpdateOne {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return muo.AddGroupIDs(ids...)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (muo *MembershipUpdateOne) Mutation() *MembershipMutation {
+	return muo.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (muo *MembershipUpdateOne) ClearWorkspace() *MembershipUpdateOne {
+	muo.mutation.ClearWorkspace()
+	return muo
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (muo *MembershipUpdateOne) ClearGroups() *MembershipUpdateOne {
+	muo.mutation.ClearGroups()
+	return muo
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (muo *MembershipUpdateOne) RemoveGroupIDs(ids ...string) *MembershipUpdateOne {
+	muo.mutation.RemoveGroupIDs(ids...)
+	return muo
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (muo *MembershipUpdateOne) RemoveGroups(g ...*Group) *MembershipUpdateOne {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return muo.RemoveGroupIDs(ids...)
+}
+
+// Save executes the query and returns the updated Membership entity.
+func (muo *MembershipUpdateOne) Save(ctx context.Context) (*Membership, error) {
+	var (
+		err  error
+		node *Membership
+	)
+	if len(muo.hooks) == 0 {
+		if err = muo.check(); err != nil {
+			return nil, err
+		}
+		node, err = muo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MembershipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = muo.check(); err != nil {
+				return nil, err
+			}
+			muo.mutation = mutation
+			node, err = muo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(muo.hooks) - 1; i >= 0; i-- {
+			mut = muo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, muo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MembershipUpdateOne) SaveX(ctx context.Context) *Membership {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MembershipUpdateOne) check() error {
+	if v, ok := muo.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("models: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := muo.mutation.WorkspaceID(); muo.mutation.WorkspaceCleared() && !ok {
+		return errors.New("models: clearing a required unique edge \"workspace\"")
+	}
+	return nil
+}
+
+func (muo *MembershipUpdateOne) sqlSave(ctx context.Context) (_node *Membership, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   membership.Table,
+			Columns: membership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: membership.FieldID,
+			},
+		},
+	}
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Membership.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.AccountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: membership.FieldAccountID,
+		})
+	}
+	if value, ok := muo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: membership.FieldEmail,
+		})
+	}
+	if value, ok := muo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: membership.FieldRole,
+		})
+	}
+	if muo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.WorkspaceTable,
+			Columns: []string{membership.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.WorkspaceTable,
+			Columns: []string{membership.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: workspace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !muo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   membership.GroupsTable,
+			Columns: membership.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Membership{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -2,27 +2,4 @@
 
 package models
 
-import (
-	"time"
-
-	"github.com/adnaan/gomodest-starter/app/gen/models/task"
-	"github.com/adnaan/gomodest-starter/app/schema"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	taskFields := schema.Task{}.Fields()
-	_ = taskFields
-	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[4].Descriptor()
-	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
-	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
-	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[5].Descriptor()
-	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
-	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
-}
+// The schema-stitching logic is generated in github.com/adnaan/gomodest-starter/app/gen/models/runtime/runtime.go
@@ -0,0 +1,71 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the subscription type in the database.
+	Label = "subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBillingID holds the string denoting the billing_id field in the database.
+	FieldBillingID = "billing_id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPriceID holds the string denoting the price_id field in the database.
+	FieldPriceID = "price_id"
+	// FieldCurrentPeriodEnd holds the string denoting the current_period_end field in the database.
+	FieldCurrentPeriodEnd = "current_period_end"
+	// FieldCancelAtPeriodEnd holds the string denoting the cancel_at_period_end field in the database.
+	FieldCancelAtPeriodEnd = "cancel_at_period_end"
+	// FieldLastEventAt holds the string denoting the last_event_at field in the database.
+	FieldLastEventAt = "last_event_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the subscription in the database.
+	Table = "subscriptions"
+)
+
+// Columns holds all SQL columns for subscription fields.
+var Columns = []string{
+	FieldID,
+	FieldBillingID,
+	FieldOwner,
+	FieldStatus,
+	FieldPriceID,
+	FieldCurrentPeriodEnd,
+	FieldCancelAtPeriodEnd,
+	FieldLastEventAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCancelAtPeriodEnd holds the default value on creation for the "cancel_at_period_end" field.
+	DefaultCancelAtPeriodEnd bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,401 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/subscription"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+}
+
+// SetBillingID sets the "billing_id" field.
+func (sc *SubscriptionCreate) SetBillingID(s string) *SubscriptionCreate {
+	sc.mutation.SetBillingID(s)
+	return sc
+}
+
+// SetOwner sets the "owner" field.
+func (sc *SubscriptionCreate) SetOwner(s string) *SubscriptionCreate {
+	sc.mutation.SetOwner(s)
+	return sc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableOwner(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetOwner(*s)
+	}
+	return sc
+}
+
+// SetStatus sets the "status" field.
+func (sc *SubscriptionCreate) SetStatus(s string) *SubscriptionCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableStatus(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetPriceID sets the "price_id" field.
+func (sc *SubscriptionCreate) SetPriceID(s string) *SubscriptionCreate {
+	sc.mutation.SetPriceID(s)
+	return sc
+}
+
+// SetNillablePriceID sets the "price_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePriceID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetPriceID(*s)
+	}
+	return sc
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (sc *SubscriptionCreate) SetCurrentPeriodEnd(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCurrentPeriodEnd(t)
+	return sc
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCurrentPeriodEnd(*t)
+	}
+	return sc
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (sc *SubscriptionCreate) SetCancelAtPeriodEnd(b bool) *SubscriptionCreate {
+	sc.mutation.SetCancelAtPeriodEnd(b)
+	return sc
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetCancelAtPeriodEnd(*b)
+	}
+	return sc
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (sc *SubscriptionCreate) SetLastEventAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetLastEventAt(t)
+	return sc
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableLastEventAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetLastEventAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SubscriptionCreate) SetCreatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SubscriptionCreate) SetUpdatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SubscriptionCreate) SetID(s string) *SubscriptionCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return sc.mutation
+}
+
+// Save creates the Subscription in the database.
+func (sc *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	var (
+		err  error
+		node *Subscription
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			node, err = sc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			mut = sc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SubscriptionCreate) defaults() {
+	if _, ok := sc.mutation.Status(); !ok {
+		v := subscription.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
+		v := subscription.DefaultCancelAtPeriodEnd
+		sc.mutation.SetCancelAtPeriodEnd(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := subscription.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SubscriptionCreate) check() error {
+	if _, ok := sc.mutation.BillingID(); !ok {
+		return &ValidationError{Name: "billing_id", err: errors.New("models: missing required field \"billing_id\"")}
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New("models: missing required field \"status\"")}
+	}
+	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
+		return &ValidationError{Name: "cancel_at_period_end", err: errors.New("models: missing required field \"cancel_at_period_end\"")}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New("models: missing required field \"updated_at\"")}
+	}
+	return nil
+}
+
+func (sc *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: subscription.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: subscription.FieldID,
+			},
+		}
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.BillingID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldBillingID,
+		})
+		_node.BillingID = value
+	}
+	if value, ok := sc.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.PriceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldPriceID,
+		})
+		_node.PriceID = value
+	}
+	if value, ok := sc.mutation.CurrentPeriodEnd(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCurrentPeriodEnd,
+		})
+		_node.CurrentPeriodEnd = &value
+	}
+	if value, ok := sc.mutation.CancelAtPeriodEnd(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: subscription.FieldCancelAtPeriodEnd,
+		})
+		_node.CancelAtPeriodEnd = value
+	}
+	if value, ok := sc.mutation.LastEventAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldLastEventAt,
+		})
+		_node.LastEventAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	builders []*SubscriptionCreate
+}
+
+// Save creates the Subscription entities in the database.
+func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Subscription, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,895 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/subscription"
+)
+
+// SubscriptionQuery is the builder for querying Subscription entities.
+type SubscriptionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Subscription
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SubscriptionQuery builder.
+func (sq *SubscriptionQuery) Where(ps ...predicate.Subscription) *SubscriptionQuery {
+	sq.predicates = append(sq.predicates, ps...)
+	return sq
+}
+
+// Limit adds a limit step to the query.
+func (sq *SubscriptionQuery) Limit(limit int) *SubscriptionQuery {
+	sq.limit = &limit
+	return sq
+}
+
+// Offset adds an offset step to the query.
+func (sq *SubscriptionQuery) Offset(offset int) *SubscriptionQuery {
+	sq.offset = &offset
+	return sq
+}
+
+// Order adds an order step to the query.
+func (sq *SubscriptionQuery) Order(o ...OrderFunc) *SubscriptionQuery {
+	sq.order = append(sq.order, o...)
+	return sq
+}
+
+// First returns the first Subscription entity from the query.
+// Returns a *NotFoundError when no Subscription was found.
+func (sq *SubscriptionQuery) First(ctx context.Context) (*Subscription, error) {
+	nodes, err := sq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{subscription.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sq *SubscriptionQuery) FirstX(ctx context.Context) *Subscription {
+	node, err := sq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Subscription ID from the query.
+// Returns a *NotFoundError when no Subscription ID was found.
+func (sq *SubscriptionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{subscription.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sq *SubscriptionQuery) FirstIDX(ctx context.Context) string {
+	id, err := sq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Subscription entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Subscription entity is not found.
+// Returns a *NotFoundError when no Subscription entities are found.
+func (sq *SubscriptionQuery) Only(ctx context.Context) (*Subscription, error) {
+	nodes, err := sq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{subscription.Label}
+	default:
+		return nil, &NotSingularError{subscription.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sq *SubscriptionQuery) OnlyX(ctx context.Context) *Subscription {
+	node, err := sq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Subscription ID in the query.
+// Returns a *NotSingularError when exactly one Subscription ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (sq *SubscriptionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = &NotSingularError{subscription.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sq *SubscriptionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := sq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Subscriptions.
+func (sq *SubscriptionQuery) All(ctx context.Context) ([]*Subscription, error) {
+	if err := sq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return sq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sq *SubscriptionQuery) AllX(ctx context.Context) []*Subscription {
+	nodes, err := sq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Subscription IDs.
+func (sq *SubscriptionQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := sq.Select(subscription.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sq *SubscriptionQuery) IDsX(ctx context.Context) []string {
+	ids, err := sq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sq *SubscriptionQuery) Count(ctx context.Context) (int, error) {
+	if err := sq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return sq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sq *SubscriptionQuery) CountX(ctx context.Context) int {
+	count, err := sq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sq *SubscriptionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := sq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return sq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sq *SubscriptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := sq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SubscriptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sq *SubscriptionQuery) Clone() *SubscriptionQuery {
+	if sq == nil {
+		return nil
+	}
+	return &SubscriptionQuery{
+		config:     sq.config,
+		limit:      sq.limit,
+		offset:     sq.offset,
+		order:      append([]OrderFunc{}, sq.order...),
+		predicates: append([]predicate.Subscription{}, sq.predicates...),
+		// clone intermediate query.
+		sql:  sq.sql.Clone(),
+		path: sq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BillingID string `json:"billing_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Subscription.Query().
+//		GroupBy(subscription.FieldBillingID).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (sq *SubscriptionQuery) GroupBy(field string, fields ...string) *SubscriptionGroupBy {
+	group := &SubscriptionGroupBy{config: sq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return sq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BillingID string `json:"billing_id,omitempty"`
+//	}
+//
+//	client.Subscription.Query().
+//		Select(subscription.FieldBillingID).
+//		Scan(ctx, &v)
+//
+func (sq *SubscriptionQuery) Select(field string, fields ...string) *SubscriptionSelect {
+	sq.fields = append([]string{field}, fields...)
+	return &SubscriptionSelect{SubscriptionQuery: sq}
+}
+
+func (sq *SubscriptionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range sq.fields {
+		if !subscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if sq.path != nil {
+		prev, err := sq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sq.sql = prev
+	}
+	return nil
+}
+
+func (sq *SubscriptionQuery) sqlAll(ctx context.Context) ([]*Subscription, error) {
+	var (
+		nodes = []*Subscription{}
+		_spec = sq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Subscription{config: sq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sq *SubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sq.querySpec()
+	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
+}
+
+func (sq *SubscriptionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := sq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (sq *SubscriptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   subscription.Table,
+			Columns: subscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: subscription.FieldID,
+			},
+		},
+		From:   sq.sql,
+		Unique: true,
+	}
+	if fields := sq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for i := range fields {
+			if fields[i] != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, subscription.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sq *SubscriptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sq.driver.Dialect())
+	t1 := builder.Table(subscription.Table)
+	selector := builder.Select(t1.Columns(subscription.Columns...)...).From(t1)
+	if sq.sql != nil {
+		selector = sq.sql
+		selector.Select(selector.Columns(subscription.Columns...)...)
+	}
+	for _, p := range sq.predicates {
+		p(selector)
+	}
+	for _, p := range sq.order {
+		p(selector, subscription.ValidColumn)
+	}
+	if offset := sq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SubscriptionGroupBy is the group-by builder for Subscription entities.
+type SubscriptionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sgb *SubscriptionGroupBy) Aggregate(fns ...AggregateFunc) *SubscriptionGroupBy {
+	sgb.fns = append(sgb.fns, fns...)
+	return sgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sgb *SubscriptionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := sgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sgb.sql = query
+	return sgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := sgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(sgb.fields) > 1 {
+		return nil, errors.New("models: SubscriptionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := sgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := sgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) StringX(ctx context.Context) string {
+	v, err := sgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(sgb.fields) > 1 {
+		return nil, errors.New("models: SubscriptionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := sgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := sgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) IntX(ctx context.Context) int {
+	v, err := sgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sgb.fields) > 1 {
+		return nil, errors.New("models: SubscriptionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := sgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := sgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := sgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(sgb.fields) > 1 {
+		return nil, errors.New("models: SubscriptionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := sgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := sgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sgb *SubscriptionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sgb *SubscriptionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := sgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sgb *SubscriptionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range sgb.fields {
+		if !subscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sgb *SubscriptionGroupBy) sqlQuery() *sql.Selector {
+	selector := sgb.sql
+	columns := make([]string, 0, len(sgb.fields)+len(sgb.fns))
+	columns = append(columns, sgb.fields...)
+	for _, fn := range sgb.fns {
+		columns = append(columns, fn(selector, subscription.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(sgb.fields...)
+}
+
+// SubscriptionSelect is the builder for selecting fields of Subscription entities.
+type SubscriptionSelect struct {
+	*SubscriptionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ss *SubscriptionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ss.sql = ss.SubscriptionQuery.sqlQuery(ctx)
+	return ss.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ss *SubscriptionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ss.fields) > 1 {
+		return nil, errors.New("models: SubscriptionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ss *SubscriptionSelect) StringsX(ctx context.Context) []string {
+	v, err := ss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ss *SubscriptionSelect) StringX(ctx context.Context) string {
+	v, err := ss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ss.fields) > 1 {
+		return nil, errors.New("models: SubscriptionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ss *SubscriptionSelect) IntsX(ctx context.Context) []int {
+	v, err := ss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ss *SubscriptionSelect) IntX(ctx context.Context) int {
+	v, err := ss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ss.fields) > 1 {
+		return nil, errors.New("models: SubscriptionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ss *SubscriptionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ss *SubscriptionSelect) Float64X(ctx context.Context) float64 {
+	v, err := ss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ss.fields) > 1 {
+		return nil, errors.New("models: SubscriptionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ss *SubscriptionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ss *SubscriptionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{subscription.Label}
+	default:
+		err = fmt.Errorf("models: SubscriptionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ss *SubscriptionSelect) BoolX(ctx context.Context) bool {
+	v, err := ss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ss *SubscriptionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ss.sqlQuery().Query()
+	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ss *SubscriptionSelect) sqlQuery() sql.Querier {
+	selector := ss.sql
+	selector.Select(selector.Columns(ss.fields...)...)
+	return selector
+}
@@ -0,0 +1,631 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/subscription"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where adds a new predicate for the SubscriptionUpdate builder.
+func (su *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	su.mutation.predicates = append(su.mutation.predicates, ps...)
+	return su
+}
+
+// SetBillingID sets the "billing_id" field.
+func (su *SubscriptionUpdate) SetBillingID(s string) *SubscriptionUpdate {
+	su.mutation.SetBillingID(s)
+	return su
+}
+
+// SetOwner sets the "owner" field.
+func (su *SubscriptionUpdate) SetOwner(s string) *SubscriptionUpdate {
+	su.mutation.SetOwner(s)
+	return su
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableOwner(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetOwner(*s)
+	}
+	return su
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (su *SubscriptionUpdate) ClearOwner() *SubscriptionUpdate {
+	su.mutation.ClearOwner()
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SubscriptionUpdate) SetStatus(s string) *SubscriptionUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableStatus(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetPriceID sets the "price_id" field.
+func (su *SubscriptionUpdate) SetPriceID(s string) *SubscriptionUpdate {
+	su.mutation.SetPriceID(s)
+	return su
+}
+
+// SetNillablePriceID sets the "price_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePriceID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetPriceID(*s)
+	}
+	return su
+}
+
+// ClearPriceID clears the value of the "price_id" field.
+func (su *SubscriptionUpdate) ClearPriceID() *SubscriptionUpdate {
+	su.mutation.ClearPriceID()
+	return su
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (su *SubscriptionUpdate) SetCurrentPeriodEnd(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCurrentPeriodEnd(t)
+	return su
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCurrentPeriodEnd(*t)
+	}
+	return su
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (su *SubscriptionUpdate) ClearCurrentPeriodEnd() *SubscriptionUpdate {
+	su.mutation.ClearCurrentPeriodEnd()
+	return su
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (su *SubscriptionUpdate) SetCancelAtPeriodEnd(b bool) *SubscriptionUpdate {
+	su.mutation.SetCancelAtPeriodEnd(b)
+	return su
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetCancelAtPeriodEnd(*b)
+	}
+	return su
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (su *SubscriptionUpdate) SetLastEventAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetLastEventAt(t)
+	return su
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableLastEventAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetLastEventAt(*t)
+	}
+	return su
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (su *SubscriptionUpdate) ClearLastEventAt() *SubscriptionUpdate {
+	su.mutation.ClearLastEventAt()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SubscriptionUpdate) SetUpdatedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	su.defaults()
+	if len(su.hooks) == 0 {
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SubscriptionUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   subscription.Table,
+			Columns: subscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: subscription.FieldID,
+			},
+		},
+	}
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.BillingID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldBillingID,
+		})
+	}
+	if value, ok := su.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldOwner,
+		})
+	}
+	if su.mutation.OwnerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: subscription.FieldOwner,
+		})
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldStatus,
+		})
+	}
+	if value, ok := su.mutation.PriceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldPriceID,
+		})
+	}
+	if su.mutation.PriceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: subscription.FieldPriceID,
+		})
+	}
+	if value, ok := su.mutation.CurrentPeriodEnd(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCurrentPeriodEnd,
+		})
+	}
+	if su.mutation.CurrentPeriodEndCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: subscription.FieldCurrentPeriodEnd,
+		})
+	}
+	if value, ok := su.mutation.CancelAtPeriodEnd(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: subscription.FieldCancelAtPeriodEnd,
+		})
+	}
+	if value, ok := su.mutation.LastEventAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldLastEventAt,
+		})
+	}
+	if su.mutation.LastEventAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: subscription.FieldLastEventAt,
+		})
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetBillingID sets the "billing_id" field.
+func (suo *SubscriptionUpdateOne) SetBillingID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetBillingID(s)
+	return suo
+}
+
+// SetOwner sets the "owner" field.
+func (suo *SubscriptionUpdateOne) SetOwner(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetOwner(s)
+	return suo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableOwner(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetOwner(*s)
+	}
+	return suo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (suo *SubscriptionUpdateOne) ClearOwner() *SubscriptionUpdateOne {
+	suo.mutation.ClearOwner()
+	return suo
+}
+
+// SetStatus sets the "status" field.
+func (suo *SubscriptionUpdateOne) SetStatus(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableStatus(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetPriceID sets the "price_id" field.
+func (suo *SubscriptionUpdateOne) SetPriceID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetPriceID(s)
+	return suo
+}
+
+// SetNillablePriceID sets the "price_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePriceID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetPriceID(*s)
+	}
+	return suo
+}
+
+// ClearPriceID clears the value of the "price_id" field.
+func (suo *SubscriptionUpdateOne) ClearPriceID() *SubscriptionUpdateOne {
+	suo.mutation.ClearPriceID()
+	return suo
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (suo *SubscriptionUpdateOne) SetCurrentPeriodEnd(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCurrentPeriodEnd(t)
+	return suo
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCurrentPeriodEnd(*t)
+	}
+	return suo
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (suo *SubscriptionUpdateOne) ClearCurrentPeriodEnd() *SubscriptionUpdateOne {
+	suo.mutation.ClearCurrentPeriodEnd()
+	return suo
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (suo *SubscriptionUpdateOne) SetCancelAtPeriodEnd(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetCancelAtPeriodEnd(b)
+	return suo
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetCancelAtPeriodEnd(*b)
+	}
+	return suo
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (suo *SubscriptionUpdateOne) SetLastEventAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetLastEventAt(t)
+	return suo
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableLastEventAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetLastEventAt(*t)
+	}
+	return suo
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (suo *SubscriptionUpdateOne) ClearLastEventAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearLastEventAt()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SubscriptionUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return suo.mutation
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (suo *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	var (
+		err  error
+		node *Subscription
+	)
+	suo.defaults()
+	if len(suo.hooks) == 0 {
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			mut = suo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, suo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SubscriptionUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   subscription.Table,
+			Columns: subscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: subscription.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Subscription.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.BillingID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldBillingID,
+		})
+	}
+	if value, ok := suo.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldOwner,
+		})
+	}
+	if suo.mutation.OwnerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: subscription.FieldOwner,
+		})
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldStatus,
+		})
+	}
+	if value, ok := suo.mutation.PriceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldPriceID,
+		})
+	}
+	if suo.mutation.PriceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: subscription.FieldPriceID,
+		})
+	}
+	if value, ok := suo.mutation.CurrentPeriodEnd(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCurrentPeriodEnd,
+		})
+	}
+	if suo.mutation.CurrentPeriodEndCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: subscription.FieldCurrentPeriodEnd,
+		})
+	}
+	if value, ok := suo.mutation.CancelAtPeriodEnd(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: subscription.FieldCancelAtPeriodEnd,
+		})
+	}
+	if value, ok := suo.mutation.LastEventAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldLastEventAt,
+		})
+	}
+	if suo.mutation.LastEventAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: subscription.FieldLastEventAt,
+		})
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldUpdatedAt,
+		})
+	}
+	_node = &Subscription{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
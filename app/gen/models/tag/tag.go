@@ -0,0 +1,58 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package tag
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the tag type in the database.
+	Label = "tag"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTasks holds the string denoting the tasks edge name in mutations.
+	EdgeTasks = "tasks"
+	// Table holds the table name of the tag in the database.
+	Table = "tags"
+	// TasksTable is the table the holds the tasks relation/edge. The primary key declared below.
+	TasksTable = "task_tags"
+	// TasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TasksInverseTable = "tasks"
+)
+
+// Columns holds all SQL columns for tag fields.
+var Columns = []string{
+	FieldID,
+	FieldOwner,
+	FieldName,
+	FieldCreatedAt,
+}
+
+var (
+	// TasksPrimaryKey and TasksColumn2 are the table columns denoting the
+	// primary key for the tasks relation (M2M).
+	TasksPrimaryKey = []string{"task_id", "tag_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
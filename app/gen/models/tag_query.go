@@ -0,0 +1,1002 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+// TagQuery is the builder for querying Tag entities.
+type TagQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Tag
+	// eager-loading edges.
+	withTasks *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TagQuery builder.
+func (tq *TagQuery) Where(ps ...predicate.Tag) *TagQuery {
+	tq.predicates = append(tq.predicates, ps...)
+	return tq
+}
+
+// Limit adds a limit step to the query.
+func (tq *TagQuery) Limit(limit int) *TagQuery {
+	tq.limit = &limit
+	return tq
+}
+
+// Offset adds an offset step to the query.
+func (tq *TagQuery) Offset(offset int) *TagQuery {
+	tq.offset = &offset
+	return tq
+}
+
+// Order adds an order step to the query.
+func (tq *TagQuery) Order(o ...OrderFunc) *TagQuery {
+	tq.order = append(tq.order, o...)
+	return tq
+}
+
+// QueryTasks chains the current query on the "tasks" edge.
+func (tq *TagQuery) QueryTasks() *TaskQuery {
+	query := &TaskQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.TasksTable, tag.TasksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Tag entity from the query.
+// Returns a *NotFoundError when no Tag was found.
+func (tq *TagQuery) First(ctx context.Context) (*Tag, error) {
+	nodes, err := tq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tag.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tq *TagQuery) FirstX(ctx context.Context) *Tag {
+	node, err := tq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Tag ID from the query.
+// Returns a *NotFoundError when no Tag ID was found.
+func (tq *TagQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tag.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tq *TagQuery) FirstIDX(ctx context.Context) string {
+	id, err := tq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Tag entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Tag entity is not found.
+// Returns a *NotFoundError when no Tag entities are found.
+func (tq *TagQuery) Only(ctx context.Context) (*Tag, error) {
+	nodes, err := tq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tag.Label}
+	default:
+		return nil, &NotSingularError{tag.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tq *TagQuery) OnlyX(ctx context.Context) *Tag {
+	node, err := tq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Tag ID in the query.
+// Returns a *NotSingularError when exactly one Tag ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (tq *TagQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = &NotSingularError{tag.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tq *TagQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Tags.
+func (tq *TagQuery) All(ctx context.Context) ([]*Tag, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return tq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tq *TagQuery) AllX(ctx context.Context) []*Tag {
+	nodes, err := tq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Tag IDs.
+func (tq *TagQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := tq.Select(tag.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tq *TagQuery) IDsX(ctx context.Context) []string {
+	ids, err := tq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tq *TagQuery) Count(ctx context.Context) (int, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return tq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tq *TagQuery) CountX(ctx context.Context) int {
+	count, err := tq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tq *TagQuery) Exist(ctx context.Context) (bool, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return tq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tq *TagQuery) ExistX(ctx context.Context) bool {
+	exist, err := tq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TagQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tq *TagQuery) Clone() *TagQuery {
+	if tq == nil {
+		return nil
+	}
+	return &TagQuery{
+		config:     tq.config,
+		limit:      tq.limit,
+		offset:     tq.offset,
+		order:      append([]OrderFunc{}, tq.order...),
+		predicates: append([]predicate.Tag{}, tq.predicates...),
+		withTasks:  tq.withTasks.Clone(),
+		// clone intermediate query.
+		sql:  tq.sql.Clone(),
+		path: tq.path,
+	}
+}
+
+// WithTasks tells the query-builder to eager-load the nodes that are connected to
+// the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TagQuery) WithTasks(opts ...func(*TaskQuery)) *TagQuery {
+	query := &TaskQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTasks = query
+	return tq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Tag.Query().
+//		GroupBy(tag.FieldOwner).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (tq *TagQuery) GroupBy(field string, fields ...string) *TagGroupBy {
+	group := &TagGroupBy{config: tq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return tq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//	}
+//
+//	client.Tag.Query().
+//		Select(tag.FieldOwner).
+//		Scan(ctx, &v)
+//
+func (tq *TagQuery) Select(field string, fields ...string) *TagSelect {
+	tq.fields = append([]string{field}, fields...)
+	return &TagSelect{TagQuery: tq}
+}
+
+func (tq *TagQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range tq.fields {
+		if !tag.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if tq.path != nil {
+		prev, err := tq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tq.sql = prev
+	}
+	return nil
+}
+
+func (tq *TagQuery) sqlAll(ctx context.Context) ([]*Tag, error) {
+	var (
+		nodes       = []*Tag{}
+		_spec       = tq.querySpec()
+		loadedTypes = [1]bool{
+			tq.withTasks != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Tag{config: tq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := tq.withTasks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[string]*Tag, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.Tasks = []*Task{}
+		}
+		var (
+			edgeids []string
+			edges   = make(map[string][]*Tag)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   tag.TasksTable,
+				Columns: tag.TasksPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(tag.TasksPrimaryKey[1], fks...))
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullString{}, &sql.NullString{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullString)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullString)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := eout.String
+				inValue := ein.String
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				edgeids = append(edgeids, inValue)
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, tq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "tasks": %w`, err)
+		}
+		query.Where(task.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "tasks" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Tasks = append(nodes[i].Edges.Tasks, n)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (tq *TagQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tq.querySpec()
+	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
+}
+
+func (tq *TagQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := tq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (tq *TagQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   tag.Table,
+			Columns: tag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: tag.FieldID,
+			},
+		},
+		From:   tq.sql,
+		Unique: true,
+	}
+	if fields := tq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tag.FieldID)
+		for i := range fields {
+			if fields[i] != tag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, tag.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tq *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tq.driver.Dialect())
+	t1 := builder.Table(tag.Table)
+	selector := builder.Select(t1.Columns(tag.Columns...)...).From(t1)
+	if tq.sql != nil {
+		selector = tq.sql
+		selector.Select(selector.Columns(tag.Columns...)...)
+	}
+	for _, p := range tq.predicates {
+		p(selector)
+	}
+	for _, p := range tq.order {
+		p(selector, tag.ValidColumn)
+	}
+	if offset := tq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TagGroupBy is the group-by builder for Tag entities.
+type TagGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tgb *TagGroupBy) Aggregate(fns ...AggregateFunc) *TagGroupBy {
+	tgb.fns = append(tgb.fns, fns...)
+	return tgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tgb *TagGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tgb.sql = query
+	return tgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tgb *TagGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := tgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("models: TagGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tgb *TagGroupBy) StringsX(ctx context.Context) []string {
+	v, err := tgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tgb *TagGroupBy) StringX(ctx context.Context) string {
+	v, err := tgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("models: TagGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tgb *TagGroupBy) IntsX(ctx context.Context) []int {
+	v, err := tgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tgb *TagGroupBy) IntX(ctx context.Context) int {
+	v, err := tgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("models: TagGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tgb *TagGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := tgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tgb *TagGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := tgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("models: TagGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tgb *TagGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := tgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TagGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tgb *TagGroupBy) BoolX(ctx context.Context) bool {
+	v, err := tgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tgb *TagGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range tgb.fields {
+		if !tag.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := tgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (tgb *TagGroupBy) sqlQuery() *sql.Selector {
+	selector := tgb.sql
+	columns := make([]string, 0, len(tgb.fields)+len(tgb.fns))
+	columns = append(columns, tgb.fields...)
+	for _, fn := range tgb.fns {
+		columns = append(columns, fn(selector, tag.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(tgb.fields...)
+}
+
+// TagSelect is the builder for selecting fields of Tag entities.
+type TagSelect struct {
+	*TagQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ts *TagSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ts.sql = ts.TagQuery.sqlQuery(ctx)
+	return ts.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ts *TagSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ts.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("models: TagSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ts *TagSelect) StringsX(ctx context.Context) []string {
+	v, err := ts.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ts.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ts *TagSelect) StringX(ctx context.Context) string {
+	v, err := ts.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("models: TagSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ts *TagSelect) IntsX(ctx context.Context) []int {
+	v, err := ts.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ts.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ts *TagSelect) IntX(ctx context.Context) int {
+	v, err := ts.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("models: TagSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ts *TagSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ts.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ts.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ts *TagSelect) Float64X(ctx context.Context) float64 {
+	v, err := ts.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("models: TagSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ts *TagSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ts.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ts *TagSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ts.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{tag.Label}
+	default:
+		err = fmt.Errorf("models: TagSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ts *TagSelect) BoolX(ctx context.Context) bool {
+	v, err := ts.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ts *TagSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ts.sqlQuery().Query()
+	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ts *TagSelect) sqlQuery() sql.Querier {
+	selector := ts.sql
+	selector.Select(selector.Columns(ts.fields...)...)
+	return selector
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
 	"github.com/adnaan/gomodest-starter/app/gen/models/task"
 )
 
@@ -26,6 +27,52 @@ type Task struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority task.Priority `json:"priority,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskQuery when eager-loading is set.
+	Edges      TaskEdges `json:"edges"`
+	task_group *string
+}
+
+// TaskEdges holds the relations/edges for other nodes in the graph.
+type TaskEdges struct {
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[0] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[1] {
+		if e.Group == nil {
+			// The edge group was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -33,10 +80,14 @@ func (*Task) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldID, task.FieldOwner, task.FieldText, task.FieldStatus:
+		case task.FieldVersion, task.FieldPosition:
+			values[i] = &sql.NullInt64{}
+		case task.FieldID, task.FieldOwner, task.FieldText, task.FieldStatus, task.FieldPriority:
 			values[i] = &sql.NullString{}
-		case task.FieldCreatedAt, task.FieldUpdatedAt:
+		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDueAt:
 			values[i] = &sql.NullTime{}
+		case task.ForeignKeys[0]: // task_group
+			values[i] = &sql.NullString{}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Task", columns[i])
 		}
@@ -88,11 +139,53 @@ func (t *Task) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.UpdatedAt = value.Time
 			}
+		case task.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
+			}
+		case task.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				t.DueAt = new(time.Time)
+				*t.DueAt = value.Time
+			}
+		case task.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				t.Priority = task.Priority(value.String)
+			}
+		case task.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				t.Position = int(value.Int64)
+			}
+		case task.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_group", values[i])
+			} else if value.Valid {
+				t.task_group = new(string)
+				*t.task_group = value.String
+			}
 		}
 	}
 	return nil
 }
 
+// QueryTags queries the "tags" edge of the Task entity.
+func (t *Task) QueryTags() *TagQuery {
+	return (&TaskClient{config: t.config}).QueryTags(t)
+}
+
+// QueryGroup queries the "group" edge of the Task entity.
+func (t *Task) QueryGroup() *GroupQuery {
+	return (&TaskClient{config: t.config}).QueryGroup(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -126,6 +219,16 @@ func (t *Task) String() string {
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", updated_at=")
 	builder.WriteString(t.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
+	if v := t.DueAt; v != nil {
+		builder.WriteString(", due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.Priority))
+	builder.WriteString(", position=")
+	builder.WriteString(fmt.Sprintf("%v", t.Position))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -10,6 +10,8 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
 	"github.com/adnaan/gomodest-starter/app/gen/models/task"
 )
 
@@ -74,12 +76,102 @@ func (tc *TaskCreate) SetNillableUpdatedAt(t *time.Time) *TaskCreate {
 	return tc
 }
 
+// SetVersion sets the "version" field.
+func (tc *TaskCreate) SetVersion(i int) *TaskCreate {
+	tc.mutation.SetVersion(i)
+	return tc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableVersion(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetVersion(*i)
+	}
+	return tc
+}
+
+// SetDueAt sets the "due_at" field.
+func (tc *TaskCreate) SetDueAt(t time.Time) *TaskCreate {
+	tc.mutation.SetDueAt(t)
+	return tc
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableDueAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetDueAt(*t)
+	}
+	return tc
+}
+
+// SetPriority sets the "priority" field.
+func (tc *TaskCreate) SetPriority(t task.Priority) *TaskCreate {
+	tc.mutation.SetPriority(t)
+	return tc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePriority(t *task.Priority) *TaskCreate {
+	if t != nil {
+		tc.SetPriority(*t)
+	}
+	return tc
+}
+
+// SetPosition sets the "position" field.
+func (tc *TaskCreate) SetPosition(i int) *TaskCreate {
+	tc.mutation.SetPosition(i)
+	return tc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePosition(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetPosition(*i)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TaskCreate) SetID(s string) *TaskCreate {
 	tc.mutation.SetID(s)
 	return tc
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tc *TaskCreate) AddTagIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddTagIDs(ids...)
+	return tc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tc *TaskCreate) AddTags(t ...*Tag) *TaskCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTagIDs(ids...)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (tc *TaskCreate) SetGroupID(id string) *TaskCreate {
+	tc.mutation.SetGroupID(id)
+	return tc
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (tc *TaskCreate) SetNillableGroupID(id *string) *TaskCreate {
+	if id != nil {
+		tc = tc.SetGroupID(*id)
+	}
+	return tc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (tc *TaskCreate) SetGroup(g *Group) *TaskCreate {
+	return tc.SetGroupID(g.ID)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -144,6 +236,18 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultUpdatedAt()
 		tc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		v := task.DefaultVersion
+		tc.mutation.SetVersion(v)
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		v := task.DefaultPriority
+		tc.mutation.SetPriority(v)
+	}
+	if _, ok := tc.mutation.Position(); !ok {
+		v := task.DefaultPosition
+		tc.mutation.SetPosition(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -165,6 +269,20 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New("models: missing required field \"updated_at\"")}
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New("models: missing required field \"version\"")}
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New("models: missing required field \"priority\"")}
+	}
+	if v, ok := tc.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf("models: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	if _, ok := tc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New("models: missing required field \"position\"")}
+	}
 	return nil
 }
 
@@ -234,6 +352,77 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		})
 		_node.UpdatedAt = value
 	}
+	if value, ok := tc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := tc.mutation.DueAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: task.FieldDueAt,
+		})
+		_node.DueAt = &value
+	}
+	if value, ok := tc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: task.FieldPriority,
+		})
+		_node.Priority = value
+	}
+	if value, ok := tc.mutation.Position(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldPosition,
+		})
+		_node.Position = value
+	}
+	if nodes := tc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.GroupTable,
+			Columns: []string{task.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.task_group = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -10,7 +10,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
 	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
 	"github.com/adnaan/gomodest-starter/app/gen/models/task"
 )
 
@@ -65,11 +67,148 @@ func (tu *TaskUpdate) SetUpdatedAt(t time.Time) *TaskUpdate {
 	return tu
 }
 
+// SetVersion sets the "version" field.
+func (tu *TaskUpdate) SetVersion(i int) *TaskUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableVersion(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TaskUpdate) AddVersion(i int) *TaskUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
+// SetDueAt sets the "due_at" field.
+func (tu *TaskUpdate) SetDueAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetDueAt(t)
+	return tu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDueAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetDueAt(*t)
+	}
+	return tu
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tu *TaskUpdate) ClearDueAt() *TaskUpdate {
+	tu.mutation.ClearDueAt()
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TaskUpdate) SetPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.SetPriority(t)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePriority(t *task.Priority) *TaskUpdate {
+	if t != nil {
+		tu.SetPriority(*t)
+	}
+	return tu
+}
+
+// SetPosition sets the "position" field.
+func (tu *TaskUpdate) SetPosition(i int) *TaskUpdate {
+	tu.mutation.ResetPosition()
+	tu.mutation.SetPosition(i)
+	return tu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePosition(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetPosition(*i)
+	}
+	return tu
+}
+
+// AddPosition adds i to the "position" field.
+func (tu *TaskUpdate) AddPosition(i int) *TaskUpdate {
+	tu.mutation.AddPosition(i)
+	return tu
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tu *TaskUpdate) AddTagIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddTagIDs(ids...)
+	return tu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tu *TaskUpdate) AddTags(t ...*Tag) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTagIDs(ids...)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (tu *TaskUpdate) SetGroupID(id string) *TaskUpdate {
+	tu.mutation.SetGroupID(id)
+	return tu
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (tu *TaskUpdate) SetNillableGroupID(id *string) *TaskUpdate {
+	if id != nil {
+		tu = tu.SetGroupID(*id)
+	}
+	return tu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (tu *TaskUpdate) SetGroup(g *Group) *TaskUpdate {
+	return tu.SetGroupID(g.ID)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tu *TaskUpdate) Mutation() *TaskMutation {
 	return tu.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tu *TaskUpdate) ClearTags() *TaskUpdate {
+	tu.mutation.ClearTags()
+	return tu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tu *TaskUpdate) RemoveTagIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveTagIDs(ids...)
+	return tu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tu *TaskUpdate) RemoveTags(t ...*Tag) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTagIDs(ids...)
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (tu *TaskUpdate) ClearGroup() *TaskUpdate {
+	tu.mutation.ClearGroup()
+	return tu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -143,6 +282,11 @@ func (tu *TaskUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf("models: validator failed for field \"status\": %w", err)}
 		}
 	}
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf("models: validator failed for field \"priority\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -198,6 +342,143 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: task.FieldUpdatedAt,
 		})
 	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldVersion,
+		})
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldVersion,
+		})
+	}
+	if value, ok := tu.mutation.DueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: task.FieldDueAt,
+		})
+	}
+	if tu.mutation.DueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: task.FieldDueAt,
+		})
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: task.FieldPriority,
+		})
+	}
+	if value, ok := tu.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldPosition,
+		})
+	}
+	if value, ok := tu.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldPosition,
+		})
+	}
+	if tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.GroupTable,
+			Columns: []string{task.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.GroupTable,
+			Columns: []string{task.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{task.Label}
@@ -254,11 +535,148 @@ func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
 	return tuo
 }
 
+// SetVersion sets the "version" field.
+func (tuo *TaskUpdateOne) SetVersion(i int) *TaskUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableVersion(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
+	}
+	return tuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tuo *TaskUpdateOne) AddVersion(i int) *TaskUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (tuo *TaskUpdateOne) SetDueAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDueAt(t)
+	return tuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDueAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDueAt(*t)
+	}
+	return tuo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tuo *TaskUpdateOne) ClearDueAt() *TaskUpdateOne {
+	tuo.mutation.ClearDueAt()
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TaskUpdateOne) SetPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.SetPriority(t)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePriority(t *task.Priority) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetPriority(*t)
+	}
+	return tuo
+}
+
+// SetPosition sets the "position" field.
+func (tuo *TaskUpdateOne) SetPosition(i int) *TaskUpdateOne {
+	tuo.mutation.ResetPosition()
+	tuo.mutation.SetPosition(i)
+	return tuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePosition(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetPosition(*i)
+	}
+	return tuo
+}
+
+// AddPosition adds i to the "position" field.
+func (tuo *TaskUpdateOne) AddPosition(i int) *TaskUpdateOne {
+	tuo.mutation.AddPosition(i)
+	return tuo
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tuo *TaskUpdateOne) AddTagIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddTagIDs(ids...)
+	return tuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tuo *TaskUpdateOne) AddTags(t ...*Tag) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTagIDs(ids...)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (tuo *TaskUpdateOne) SetGroupID(id string) *TaskUpdateOne {
+	tuo.mutation.SetGroupID(id)
+	return tuo
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableGroupID(id *string) *TaskUpdateOne {
+	if id != nil {
+		tuo = tuo.SetGroupID(*id)
+	}
+	return tuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (tuo *TaskUpdateOne) SetGroup(g *Group) *TaskUpdateOne {
+	return tuo.SetGroupID(g.ID)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
 	return tuo.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tuo *TaskUpdateOne) ClearTags() *TaskUpdateOne {
+	tuo.mutation.ClearTags()
+	return tuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tuo *TaskUpdateOne) RemoveTagIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveTagIDs(ids...)
+	return tuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tuo *TaskUpdateOne) RemoveTags(t ...*Tag) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTagIDs(ids...)
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (tuo *TaskUpdateOne) ClearGroup() *TaskUpdateOne {
+	tuo.mutation.ClearGroup()
+	return tuo
+}
+
 // Save executes the query and returns the updated Task entity.
 func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
 	var (
@@ -332,6 +750,11 @@ func (tuo *TaskUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf("models: validator failed for field \"status\": %w", err)}
 		}
 	}
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf("models: validator failed for field \"priority\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -392,6 +815,143 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 			Column: task.FieldUpdatedAt,
 		})
 	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldVersion,
+		})
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldVersion,
+		})
+	}
+	if value, ok := tuo.mutation.DueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: task.FieldDueAt,
+		})
+	}
+	if tuo.mutation.DueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: task.FieldDueAt,
+		})
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: task.FieldPriority,
+		})
+	}
+	if value, ok := tuo.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldPosition,
+		})
+	}
+	if value, ok := tuo.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: task.FieldPosition,
+		})
+	}
+	if tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.TagsTable,
+			Columns: task.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.GroupTable,
+			Columns: []string{task.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.GroupTable,
+			Columns: []string{task.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Task{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
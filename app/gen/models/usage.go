@@ -0,0 +1,152 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/usage"
+)
+
+// Usage is the model entity for the Usage schema.
+type Usage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// FeatureID holds the value of the "feature_id" field.
+	FeatureID string `json:"feature_id,omitempty"`
+	// Period holds the value of the "period" field.
+	Period string `json:"period,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int64 `json:"count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Usage) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID, usage.FieldCount:
+			values[i] = &sql.NullInt64{}
+		case usage.FieldOwner, usage.FieldFeatureID, usage.FieldPeriod:
+			values[i] = &sql.NullString{}
+		case usage.FieldCreatedAt, usage.FieldUpdatedAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Usage", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Usage fields.
+func (u *Usage) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case usage.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				u.Owner = value.String
+			}
+		case usage.FieldFeatureID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_id", values[i])
+			} else if value.Valid {
+				u.FeatureID = value.String
+			}
+		case usage.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				u.Period = value.String
+			}
+		case usage.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				u.Count = value.Int64
+			}
+		case usage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		case usage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				u.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Usage.
+// Note that you need to call Usage.Unwrap() before calling this method if this Usage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *Usage) Update() *UsageUpdateOne {
+	return (&UsageClient{config: u.config}).UpdateOne(u)
+}
+
+// Unwrap unwraps the Usage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (u *Usage) Unwrap() *Usage {
+	tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Usage is not a transactional entity")
+	}
+	u.config.driver = tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *Usage) String() string {
+	var builder strings.Builder
+	builder.WriteString("Usage(")
+	builder.WriteString(fmt.Sprintf("id=%v", u.ID))
+	builder.WriteString(", owner=")
+	builder.WriteString(u.Owner)
+	builder.WriteString(", feature_id=")
+	builder.WriteString(u.FeatureID)
+	builder.WriteString(", period=")
+	builder.WriteString(u.Period)
+	builder.WriteString(", count=")
+	builder.WriteString(fmt.Sprintf("%v", u.Count))
+	builder.WriteString(", created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Usages is a parsable slice of Usage.
+type Usages []*Usage
+
+func (u Usages) config(cfg config) {
+	for _i := range u {
+		u[_i].config = cfg
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package usage
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the usage type in the database.
+	Label = "usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldFeatureID holds the string denoting the feature_id field in the database.
+	FieldFeatureID = "feature_id"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the usage in the database.
+	Table = "usages"
+)
+
+// Columns holds all SQL columns for usage fields.
+var Columns = []string{
+	FieldID,
+	FieldOwner,
+	FieldFeatureID,
+	FieldPeriod,
+	FieldCount,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCount holds the default value on creation for the "count" field.
+	DefaultCount int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
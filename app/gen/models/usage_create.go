@@ -0,0 +1,312 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/usage"
+)
+
+// UsageCreate is the builder for creating a Usage entity.
+type UsageCreate struct {
+	config
+	mutation *UsageMutation
+	hooks    []Hook
+}
+
+// SetOwner sets the "owner" field.
+func (uc *UsageCreate) SetOwner(s string) *UsageCreate {
+	uc.mutation.SetOwner(s)
+	return uc
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (uc *UsageCreate) SetFeatureID(s string) *UsageCreate {
+	uc.mutation.SetFeatureID(s)
+	return uc
+}
+
+// SetPeriod sets the "period" field.
+func (uc *UsageCreate) SetPeriod(s string) *UsageCreate {
+	uc.mutation.SetPeriod(s)
+	return uc
+}
+
+// SetCount sets the "count" field.
+func (uc *UsageCreate) SetCount(i int64) *UsageCreate {
+	uc.mutation.SetCount(i)
+	return uc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableCount(i *int64) *UsageCreate {
+	if i != nil {
+		uc.SetCount(*i)
+	}
+	return uc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uc *UsageCreate) SetCreatedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetCreatedAt(t)
+	return uc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableCreatedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetCreatedAt(*t)
+	}
+	return uc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uc *UsageCreate) SetUpdatedAt(t time.Time) *UsageCreate {
+	uc.mutation.SetUpdatedAt(t)
+	return uc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uc *UsageCreate) SetNillableUpdatedAt(t *time.Time) *UsageCreate {
+	if t != nil {
+		uc.SetUpdatedAt(*t)
+	}
+	return uc
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uc *UsageCreate) Mutation() *UsageMutation {
+	return uc.mutation
+}
+
+// Save creates the Usage in the database.
+func (uc *UsageCreate) Save(ctx context.Context) (*Usage, error) {
+	var (
+		err  error
+		node *Usage
+	)
+	uc.defaults()
+	if len(uc.hooks) == 0 {
+		if err = uc.check(); err != nil {
+			return nil, err
+		}
+		node, err = uc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UsageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = uc.check(); err != nil {
+				return nil, err
+			}
+			uc.mutation = mutation
+			node, err = uc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(uc.hooks) - 1; i >= 0; i-- {
+			mut = uc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uc *UsageCreate) SaveX(ctx context.Context) *Usage {
+	v, err := uc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (uc *UsageCreate) defaults() {
+	if _, ok := uc.mutation.Count(); !ok {
+		v := usage.DefaultCount
+		uc.mutation.SetCount(v)
+	}
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		v := usage.DefaultCreatedAt()
+		uc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		v := usage.DefaultUpdatedAt()
+		uc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uc *UsageCreate) check() error {
+	if _, ok := uc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New("models: missing required field \"owner\"")}
+	}
+	if _, ok := uc.mutation.FeatureID(); !ok {
+		return &ValidationError{Name: "feature_id", err: errors.New("models: missing required field \"feature_id\"")}
+	}
+	if _, ok := uc.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New("models: missing required field \"period\"")}
+	}
+	if _, ok := uc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New("models: missing required field \"count\"")}
+	}
+	if _, ok := uc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New("models: missing required field \"updated_at\"")}
+	}
+	return nil
+}
+
+func (uc *UsageCreate) sqlSave(ctx context.Context) (*Usage, error) {
+	_node, _spec := uc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (uc *UsageCreate) createSpec() (*Usage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Usage{config: uc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: usage.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usage.FieldID,
+			},
+		}
+	)
+	if value, ok := uc.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := uc.mutation.FeatureID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldFeatureID,
+		})
+		_node.FeatureID = value
+	}
+	if value, ok := uc.mutation.Period(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldPeriod,
+		})
+		_node.Period = value
+	}
+	if value, ok := uc.mutation.Count(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: usage.FieldCount,
+		})
+		_node.Count = value
+	}
+	if value, ok := uc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usage.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := uc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usage.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UsageCreateBulk is the builder for creating many Usage entities in bulk.
+type UsageCreateBulk struct {
+	config
+	builders []*UsageCreate
+}
+
+// Save creates the Usage entities in the database.
+func (ucb *UsageCreateBulk) Save(ctx context.Context) ([]*Usage, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
+	nodes := make([]*Usage, len(ucb.builders))
+	mutators := make([]Mutator, len(ucb.builders))
+	for i := range ucb.builders {
+		func(i int, root context.Context) {
+			builder := ucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ucb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucb *UsageCreateBulk) SaveX(ctx context.Context) []*Usage {
+	v, err := ucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
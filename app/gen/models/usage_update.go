@@ -0,0 +1,405 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/usage"
+)
+
+// UsageUpdate is the builder for updating Usage entities.
+type UsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// Where adds a new predicate for the UsageUpdate builder.
+func (uu *UsageUpdate) Where(ps ...predicate.Usage) *UsageUpdate {
+	uu.mutation.predicates = append(uu.mutation.predicates, ps...)
+	return uu
+}
+
+// SetOwner sets the "owner" field.
+func (uu *UsageUpdate) SetOwner(s string) *UsageUpdate {
+	uu.mutation.SetOwner(s)
+	return uu
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (uu *UsageUpdate) SetFeatureID(s string) *UsageUpdate {
+	uu.mutation.SetFeatureID(s)
+	return uu
+}
+
+// SetPeriod sets the "period" field.
+func (uu *UsageUpdate) SetPeriod(s string) *UsageUpdate {
+	uu.mutation.SetPeriod(s)
+	return uu
+}
+
+// SetCount sets the "count" field.
+func (uu *UsageUpdate) SetCount(i int64) *UsageUpdate {
+	uu.mutation.ResetCount()
+	uu.mutation.SetCount(i)
+	return uu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (uu *UsageUpdate) SetNillableCount(i *int64) *UsageUpdate {
+	if i != nil {
+		uu.SetCount(*i)
+	}
+	return uu
+}
+
+// AddCount adds i to the "count" field.
+func (uu *UsageUpdate) AddCount(i int64) *UsageUpdate {
+	uu.mutation.AddCount(i)
+	return uu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uu *UsageUpdate) SetUpdatedAt(t time.Time) *UsageUpdate {
+	uu.mutation.SetUpdatedAt(t)
+	return uu
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uu *UsageUpdate) Mutation() *UsageMutation {
+	return uu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UsageUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	uu.defaults()
+	if len(uu.hooks) == 0 {
+		affected, err = uu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UsageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			uu.mutation = mutation
+			affected, err = uu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(uu.hooks) - 1; i >= 0; i-- {
+			mut = uu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UsageUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UsageUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uu *UsageUpdate) defaults() {
+	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		v := usage.UpdateDefaultUpdatedAt()
+		uu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (uu *UsageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   usage.Table,
+			Columns: usage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usage.FieldID,
+			},
+		},
+	}
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uu.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldOwner,
+		})
+	}
+	if value, ok := uu.mutation.FeatureID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldFeatureID,
+		})
+	}
+	if value, ok := uu.mutation.Period(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldPeriod,
+		})
+	}
+	if value, ok := uu.mutation.Count(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: usage.FieldCount,
+		})
+	}
+	if value, ok := uu.mutation.AddedCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: usage.FieldCount,
+		})
+	}
+	if value, ok := uu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usage.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UsageUpdateOne is the builder for updating a single Usage entity.
+type UsageUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// SetOwner sets the "owner" field.
+func (uuo *UsageUpdateOne) SetOwner(s string) *UsageUpdateOne {
+	uuo.mutation.SetOwner(s)
+	return uuo
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (uuo *UsageUpdateOne) SetFeatureID(s string) *UsageUpdateOne {
+	uuo.mutation.SetFeatureID(s)
+	return uuo
+}
+
+// SetPeriod sets the "period" field.
+func (uuo *UsageUpdateOne) SetPeriod(s string) *UsageUpdateOne {
+	uuo.mutation.SetPeriod(s)
+	return uuo
+}
+
+// SetCount sets the "count" field.
+func (uuo *UsageUpdateOne) SetCount(i int64) *UsageUpdateOne {
+	uuo.mutation.ResetCount()
+	uuo.mutation.SetCount(i)
+	return uuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (uuo *UsageUpdateOne) SetNillableCount(i *int64) *UsageUpdateOne {
+	if i != nil {
+		uuo.SetCount(*i)
+	}
+	return uuo
+}
+
+// AddCount adds i to the "count" field.
+func (uuo *UsageUpdateOne) AddCount(i int64) *UsageUpdateOne {
+	uuo.mutation.AddCount(i)
+	return uuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UsageUpdateOne) SetUpdatedAt(t time.Time) *UsageUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (uuo *UsageUpdateOne) Mutation() *UsageMutation {
+	return uuo.mutation
+}
+
+// Save executes the query and returns the updated Usage entity.
+func (uuo *UsageUpdateOne) Save(ctx context.Context) (*Usage, error) {
+	var (
+		err  error
+		node *Usage
+	)
+	uuo.defaults()
+	if len(uuo.hooks) == 0 {
+		node, err = uuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UsageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			uuo.mutation = mutation
+			node, err = uuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(uuo.hooks) - 1; i >= 0; i-- {
+			mut = uuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UsageUpdateOne) SaveX(ctx context.Context) *Usage {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UsageUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UsageUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := usage.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (uuo *UsageUpdateOne) sqlSave(ctx context.Context) (_node *Usage, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   usage.Table,
+			Columns: usage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usage.FieldID,
+			},
+		},
+	}
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Usage.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldOwner,
+		})
+	}
+	if value, ok := uuo.mutation.FeatureID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldFeatureID,
+		})
+	}
+	if value, ok := uuo.mutation.Period(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usage.FieldPeriod,
+		})
+	}
+	if value, ok := uuo.mutation.Count(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: usage.FieldCount,
+		})
+	}
+	if value, ok := uuo.mutation.AddedCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: usage.FieldCount,
+		})
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usage.FieldUpdatedAt,
+		})
+	}
+	_node = &Usage{config: uuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,213 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EndpointID holds the value of the "endpoint_id" field.
+	EndpointID string `json:"endpoint_id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID string `json:"event_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode int `json:"status_code,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldPayload:
+			values[i] = &[]byte{}
+		case webhookdelivery.FieldID, webhookdelivery.FieldAttempts, webhookdelivery.FieldStatusCode:
+			values[i] = &sql.NullInt64{}
+		case webhookdelivery.FieldEndpointID, webhookdelivery.FieldEventID, webhookdelivery.FieldEventType, webhookdelivery.FieldError:
+			values[i] = &sql.NullString{}
+		case webhookdelivery.FieldDeliveredAt, webhookdelivery.FieldNextAttemptAt, webhookdelivery.FieldClaimedAt, webhookdelivery.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebhookDelivery", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wd.ID = int(value.Int64)
+		case webhookdelivery.FieldEndpointID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_id", values[i])
+			} else if value.Valid {
+				wd.EndpointID = value.String
+			}
+		case webhookdelivery.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				wd.EventID = value.String
+			}
+		case webhookdelivery.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				wd.EventType = value.String
+			}
+		case webhookdelivery.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				wd.Payload = *value
+			}
+		case webhookdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				wd.Attempts = int(value.Int64)
+			}
+		case webhookdelivery.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				wd.StatusCode = int(value.Int64)
+			}
+		case webhookdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				wd.Error = value.String
+			}
+		case webhookdelivery.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				wd.DeliveredAt = new(time.Time)
+				*wd.DeliveredAt = value.Time
+			}
+		case webhookdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				wd.NextAttemptAt = new(time.Time)
+				*wd.NextAttemptAt = value.Time
+			}
+		case webhookdelivery.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				wd.ClaimedAt = new(time.Time)
+				*wd.ClaimedAt = value.Time
+			}
+		case webhookdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return (&WebhookDeliveryClient{config: wd.config}).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("models: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v", wd.ID))
+	builder.WriteString(", endpoint_id=")
+	builder.WriteString(wd.EndpointID)
+	builder.WriteString(", event_id=")
+	builder.WriteString(wd.EventID)
+	builder.WriteString(", event_type=")
+	builder.WriteString(wd.EventType)
+	builder.WriteString(", payload=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Payload))
+	builder.WriteString(", attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Attempts))
+	builder.WriteString(", status_code=")
+	builder.WriteString(fmt.Sprintf("%v", wd.StatusCode))
+	builder.WriteString(", error=")
+	builder.WriteString(wd.Error)
+	if v := wd.DeliveredAt; v != nil {
+		builder.WriteString(", delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := wd.NextAttemptAt; v != nil {
+		builder.WriteString(", next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := wd.ClaimedAt; v != nil {
+		builder.WriteString(", claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
+
+func (wd WebhookDeliveries) config(cfg config) {
+	for _i := range wd {
+		wd[_i].config = cfg
+	}
+}
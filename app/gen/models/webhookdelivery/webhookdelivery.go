@@ -0,0 +1,71 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package webhookdelivery
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the webhookdelivery type in the database.
+	Label = "webhook_delivery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEndpointID holds the string denoting the endpoint_id field in the database.
+	FieldEndpointID = "endpoint_id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldStatusCode holds the string denoting the status_code field in the database.
+	FieldStatusCode = "status_code"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
+	FieldDeliveredAt = "delivered_at"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldClaimedAt holds the string denoting the claimed_at field in the database.
+	FieldClaimedAt = "claimed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the webhookdelivery in the database.
+	Table = "webhook_deliveries"
+)
+
+// Columns holds all SQL columns for webhookdelivery fields.
+var Columns = []string{
+	FieldID,
+	FieldEndpointID,
+	FieldEventID,
+	FieldEventType,
+	FieldPayload,
+	FieldAttempts,
+	FieldStatusCode,
+	FieldError,
+	FieldDeliveredAt,
+	FieldNextAttemptAt,
+	FieldClaimedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,410 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+}
+
+// SetEndpointID sets the "endpoint_id" field.
+func (wdc *WebhookDeliveryCreate) SetEndpointID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEndpointID(s)
+	return wdc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdc *WebhookDeliveryCreate) SetEventID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventID(s)
+	return wdc
+}
+
+// SetEventType sets the "event_type" field.
+func (wdc *WebhookDeliveryCreate) SetEventType(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventType(s)
+	return wdc
+}
+
+// SetPayload sets the "payload" field.
+func (wdc *WebhookDeliveryCreate) SetPayload(b []byte) *WebhookDeliveryCreate {
+	wdc.mutation.SetPayload(b)
+	return wdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(i)
+	return wdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempts(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempts(*i)
+	}
+	return wdc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wdc *WebhookDeliveryCreate) SetStatusCode(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatusCode(i)
+	return wdc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatusCode(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetStatusCode(*i)
+	}
+	return wdc
+}
+
+// SetError sets the "error" field.
+func (wdc *WebhookDeliveryCreate) SetError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetError(s)
+	return wdc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetError(*s)
+	}
+	return wdc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wdc *WebhookDeliveryCreate) SetDeliveredAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetDeliveredAt(t)
+	return wdc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetDeliveredAt(*t)
+	}
+	return wdc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdc *WebhookDeliveryCreate) SetNextAttemptAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetNextAttemptAt(t)
+	return wdc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetNextAttemptAt(*t)
+	}
+	return wdc
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (wdc *WebhookDeliveryCreate) SetClaimedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetClaimedAt(t)
+	return wdc
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableClaimedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetClaimedAt(*t)
+	}
+	return wdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	var (
+		err  error
+		node *WebhookDelivery
+	)
+	wdc.defaults()
+	if len(wdc.hooks) == 0 {
+		if err = wdc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wdc.check(); err != nil {
+				return nil, err
+			}
+			wdc.mutation = mutation
+			node, err = wdc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wdc.hooks) - 1; i >= 0; i-- {
+			mut = wdc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() {
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		v := webhookdelivery.DefaultAttempts
+		wdc.mutation.SetAttempts(v)
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.EndpointID(); !ok {
+		return &ValidationError{Name: "endpoint_id", err: errors.New("models: missing required field \"endpoint_id\"")}
+	}
+	if _, ok := wdc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New("models: missing required field \"event_id\"")}
+	}
+	if _, ok := wdc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New("models: missing required field \"event_type\"")}
+	}
+	if _, ok := wdc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New("models: missing required field \"payload\"")}
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New("models: missing required field \"attempts\"")}
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhookdelivery.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		}
+	)
+	if value, ok := wdc.mutation.EndpointID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEndpointID,
+		})
+		_node.EndpointID = value
+	}
+	if value, ok := wdc.mutation.EventID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventID,
+		})
+		_node.EventID = value
+	}
+	if value, ok := wdc.mutation.EventType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventType,
+		})
+		_node.EventType = value
+	}
+	if value, ok := wdc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+		_node.Payload = value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+		_node.Attempts = value
+	}
+	if value, ok := wdc.mutation.StatusCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+		_node.StatusCode = value
+	}
+	if value, ok := wdc.mutation.Error(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+		_node.Error = value
+	}
+	if value, ok := wdc.mutation.DeliveredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+		_node.DeliveredAt = &value
+	}
+	if value, ok := wdc.mutation.NextAttemptAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := wdc.mutation.ClaimedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldClaimedAt,
+		})
+		_node.ClaimedAt = &value
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	builders []*WebhookDeliveryCreate
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,895 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+)
+
+// WebhookDeliveryQuery is the builder for querying WebhookDelivery entities.
+type WebhookDeliveryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WebhookDelivery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookDeliveryQuery builder.
+func (wdq *WebhookDeliveryQuery) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryQuery {
+	wdq.predicates = append(wdq.predicates, ps...)
+	return wdq
+}
+
+// Limit adds a limit step to the query.
+func (wdq *WebhookDeliveryQuery) Limit(limit int) *WebhookDeliveryQuery {
+	wdq.limit = &limit
+	return wdq
+}
+
+// Offset adds an offset step to the query.
+func (wdq *WebhookDeliveryQuery) Offset(offset int) *WebhookDeliveryQuery {
+	wdq.offset = &offset
+	return wdq
+}
+
+// Order adds an order step to the query.
+func (wdq *WebhookDeliveryQuery) Order(o ...OrderFunc) *WebhookDeliveryQuery {
+	wdq.order = append(wdq.order, o...)
+	return wdq
+}
+
+// First returns the first WebhookDelivery entity from the query.
+// Returns a *NotFoundError when no WebhookDelivery was found.
+func (wdq *WebhookDeliveryQuery) First(ctx context.Context) (*WebhookDelivery, error) {
+	nodes, err := wdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookdelivery.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) FirstX(ctx context.Context) *WebhookDelivery {
+	node, err := wdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookDelivery ID from the query.
+// Returns a *NotFoundError when no WebhookDelivery ID was found.
+func (wdq *WebhookDeliveryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookdelivery.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) FirstIDX(ctx context.Context) int {
+	id, err := wdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookDelivery entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one WebhookDelivery entity is not found.
+// Returns a *NotFoundError when no WebhookDelivery entities are found.
+func (wdq *WebhookDeliveryQuery) Only(ctx context.Context) (*WebhookDelivery, error) {
+	nodes, err := wdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookdelivery.Label}
+	default:
+		return nil, &NotSingularError{webhookdelivery.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) OnlyX(ctx context.Context) *WebhookDelivery {
+	node, err := wdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookDelivery ID in the query.
+// Returns a *NotSingularError when exactly one WebhookDelivery ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (wdq *WebhookDeliveryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = &NotSingularError{webhookdelivery.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookDeliveries.
+func (wdq *WebhookDeliveryQuery) All(ctx context.Context) ([]*WebhookDelivery, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) AllX(ctx context.Context) []*WebhookDelivery {
+	nodes, err := wdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookDelivery IDs.
+func (wdq *WebhookDeliveryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wdq.Select(webhookdelivery.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) IDsX(ctx context.Context) []int {
+	ids, err := wdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wdq *WebhookDeliveryQuery) Count(ctx context.Context) (int, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) CountX(ctx context.Context) int {
+	count, err := wdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wdq *WebhookDeliveryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) ExistX(ctx context.Context) bool {
+	exist, err := wdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookDeliveryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wdq *WebhookDeliveryQuery) Clone() *WebhookDeliveryQuery {
+	if wdq == nil {
+		return nil
+	}
+	return &WebhookDeliveryQuery{
+		config:     wdq.config,
+		limit:      wdq.limit,
+		offset:     wdq.offset,
+		order:      append([]OrderFunc{}, wdq.order...),
+		predicates: append([]predicate.WebhookDelivery{}, wdq.predicates...),
+		// clone intermediate query.
+		sql:  wdq.sql.Clone(),
+		path: wdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EndpointID string `json:"endpoint_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookDelivery.Query().
+//		GroupBy(webhookdelivery.FieldEndpointID).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (wdq *WebhookDeliveryQuery) GroupBy(field string, fields ...string) *WebhookDeliveryGroupBy {
+	group := &WebhookDeliveryGroupBy{config: wdq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wdq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EndpointID string `json:"endpoint_id,omitempty"`
+//	}
+//
+//	client.WebhookDelivery.Query().
+//		Select(webhookdelivery.FieldEndpointID).
+//		Scan(ctx, &v)
+//
+func (wdq *WebhookDeliveryQuery) Select(field string, fields ...string) *WebhookDeliverySelect {
+	wdq.fields = append([]string{field}, fields...)
+	return &WebhookDeliverySelect{WebhookDeliveryQuery: wdq}
+}
+
+func (wdq *WebhookDeliveryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wdq.fields {
+		if !webhookdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if wdq.path != nil {
+		prev, err := wdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wdq.sql = prev
+	}
+	return nil
+}
+
+func (wdq *WebhookDeliveryQuery) sqlAll(ctx context.Context) ([]*WebhookDelivery, error) {
+	var (
+		nodes = []*WebhookDelivery{}
+		_spec = wdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WebhookDelivery{config: wdq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wdq *WebhookDeliveryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wdq.querySpec()
+	return sqlgraph.CountNodes(ctx, wdq.driver, _spec)
+}
+
+func (wdq *WebhookDeliveryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wdq *WebhookDeliveryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+		From:   wdq.sql,
+		Unique: true,
+	}
+	if fields := wdq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for i := range fields {
+			if fields[i] != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, webhookdelivery.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wdq *WebhookDeliveryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wdq.driver.Dialect())
+	t1 := builder.Table(webhookdelivery.Table)
+	selector := builder.Select(t1.Columns(webhookdelivery.Columns...)...).From(t1)
+	if wdq.sql != nil {
+		selector = wdq.sql
+		selector.Select(selector.Columns(webhookdelivery.Columns...)...)
+	}
+	for _, p := range wdq.predicates {
+		p(selector)
+	}
+	for _, p := range wdq.order {
+		p(selector, webhookdelivery.ValidColumn)
+	}
+	if offset := wdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookDeliveryGroupBy is the group-by builder for WebhookDelivery entities.
+type WebhookDeliveryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wdgb *WebhookDeliveryGroupBy) Aggregate(fns ...AggregateFunc) *WebhookDeliveryGroupBy {
+	wdgb.fns = append(wdgb.fns, fns...)
+	return wdgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wdgb *WebhookDeliveryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wdgb.sql = query
+	return wdgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wdgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliveryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wdgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wdgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliveryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) StringX(ctx context.Context) string {
+	v, err := wdgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliveryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wdgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wdgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliveryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) IntX(ctx context.Context) int {
+	v, err := wdgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliveryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wdgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wdgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliveryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wdgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliveryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wdgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wdgb *WebhookDeliveryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wdgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliveryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wdgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wdgb *WebhookDeliveryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wdgb.fields {
+		if !webhookdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wdgb *WebhookDeliveryGroupBy) sqlQuery() *sql.Selector {
+	selector := wdgb.sql
+	columns := make([]string, 0, len(wdgb.fields)+len(wdgb.fns))
+	columns = append(columns, wdgb.fields...)
+	for _, fn := range wdgb.fns {
+		columns = append(columns, fn(selector, webhookdelivery.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(wdgb.fields...)
+}
+
+// WebhookDeliverySelect is the builder for selecting fields of WebhookDelivery entities.
+type WebhookDeliverySelect struct {
+	*WebhookDeliveryQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wds *WebhookDeliverySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wds.sql = wds.WebhookDeliveryQuery.sqlQuery(ctx)
+	return wds.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wds.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliverySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) StringsX(ctx context.Context) []string {
+	v, err := wds.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wds.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliverySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) StringX(ctx context.Context) string {
+	v, err := wds.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliverySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) IntsX(ctx context.Context) []int {
+	v, err := wds.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wds.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliverySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) IntX(ctx context.Context) int {
+	v, err := wds.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliverySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wds.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wds.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliverySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) Float64X(ctx context.Context) float64 {
+	v, err := wds.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("models: WebhookDeliverySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) BoolsX(ctx context.Context) []bool {
+	v, err := wds.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wds.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("models: WebhookDeliverySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) BoolX(ctx context.Context) bool {
+	v, err := wds.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wds *WebhookDeliverySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wds.sqlQuery().Query()
+	if err := wds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wds *WebhookDeliverySelect) sqlQuery() sql.Querier {
+	selector := wds.sql
+	selector.Select(selector.Columns(wds.fields...)...)
+	return selector
+}
@@ -0,0 +1,745 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where adds a new predicate for the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.predicates = append(wdu.mutation.predicates, ps...)
+	return wdu
+}
+
+// SetEndpointID sets the "endpoint_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEndpointID(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEndpointID(s)
+	return wdu
+}
+
+// SetEventID sets the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEventID(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventID(s)
+	return wdu
+}
+
+// SetEventType sets the "event_type" field.
+func (wdu *WebhookDeliveryUpdate) SetEventType(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventType(s)
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(b []byte) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(b)
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) SetStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetStatusCode()
+	wdu.mutation.SetStatusCode(i)
+	return wdu
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatusCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) AddStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddStatusCode(i)
+	return wdu
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) ClearStatusCode() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearStatusCode()
+	return wdu
+}
+
+// SetError sets the "error" field.
+func (wdu *WebhookDeliveryUpdate) SetError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetError(s)
+	return wdu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetError(*s)
+	}
+	return wdu
+}
+
+// ClearError clears the value of the "error" field.
+func (wdu *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearError()
+	return wdu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveredAt(t)
+	return wdu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeliveredAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearDeliveredAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeliveredAt()
+	return wdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptAt(t)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptAt(*t)
+	}
+	return wdu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearNextAttemptAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearNextAttemptAt()
+	return wdu
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (wdu *WebhookDeliveryUpdate) SetClaimedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetClaimedAt(t)
+	return wdu
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableClaimedAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetClaimedAt(*t)
+	}
+	return wdu
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearClaimedAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearClaimedAt()
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdu.hooks) == 0 {
+		affected, err = wdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdu.mutation = mutation
+			affected, err = wdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdu.hooks) - 1; i >= 0; i-- {
+			mut = wdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.EndpointID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEndpointID,
+		})
+	}
+	if value, ok := wdu.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventID,
+		})
+	}
+	if value, ok := wdu.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventType,
+		})
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wdu.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if wdu.mutation.StatusCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wdu.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if value, ok := wdu.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wdu.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+	}
+	if wdu.mutation.NextAttemptAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+	}
+	if value, ok := wdu.mutation.ClaimedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldClaimedAt,
+		})
+	}
+	if wdu.mutation.ClaimedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldClaimedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetEndpointID sets the "endpoint_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEndpointID(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEndpointID(s)
+	return wduo
+}
+
+// SetEventID sets the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventID(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventID(s)
+	return wduo
+}
+
+// SetEventType sets the "event_type" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventType(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventType(s)
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(b []byte) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(b)
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetStatusCode()
+	wduo.mutation.SetStatusCode(i)
+	return wduo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatusCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddStatusCode(i)
+	return wduo
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearStatusCode() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearStatusCode()
+	return wduo
+}
+
+// SetError sets the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetError(s)
+	return wduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetError(*s)
+	}
+	return wduo
+}
+
+// ClearError clears the value of the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearError()
+	return wduo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveredAt(t)
+	return wduo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeliveredAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeliveredAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeliveredAt()
+	return wduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptAt(t)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptAt(*t)
+	}
+	return wduo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearNextAttemptAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearNextAttemptAt()
+	return wduo
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetClaimedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetClaimedAt(t)
+	return wduo
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableClaimedAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetClaimedAt(*t)
+	}
+	return wduo
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearClaimedAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearClaimedAt()
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	var (
+		err  error
+		node *WebhookDelivery
+	)
+	if len(wduo.hooks) == 0 {
+		node, err = wduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wduo.mutation = mutation
+			node, err = wduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wduo.hooks) - 1; i >= 0; i-- {
+			mut = wduo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wduo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WebhookDelivery.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.EndpointID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEndpointID,
+		})
+	}
+	if value, ok := wduo.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventID,
+		})
+	}
+	if value, ok := wduo.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventType,
+		})
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wduo.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if wduo.mutation.StatusCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wduo.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if value, ok := wduo.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wduo.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+	}
+	if wduo.mutation.NextAttemptAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+	}
+	if value, ok := wduo.mutation.ClaimedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldClaimedAt,
+		})
+	}
+	if wduo.mutation.ClaimedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldClaimedAt,
+		})
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
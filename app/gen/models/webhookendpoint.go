@@ -0,0 +1,129 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+)
+
+// WebhookEndpoint is the model entity for the WebhookEndpoint schema.
+type WebhookEndpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEndpoint) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookendpoint.FieldID, webhookendpoint.FieldOwner, webhookendpoint.FieldURL, webhookendpoint.FieldSecret:
+			values[i] = &sql.NullString{}
+		case webhookendpoint.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebhookEndpoint", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEndpoint fields.
+func (we *WebhookEndpoint) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookendpoint.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				we.ID = value.String
+			}
+		case webhookendpoint.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				we.Owner = value.String
+			}
+		case webhookendpoint.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				we.URL = value.String
+			}
+		case webhookendpoint.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				we.Secret = value.String
+			}
+		case webhookendpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WebhookEndpoint.
+// Note that you need to call WebhookEndpoint.Unwrap() before calling this method if this WebhookEndpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WebhookEndpoint) Update() *WebhookEndpointUpdateOne {
+	return (&WebhookEndpointClient{config: we.config}).UpdateOne(we)
+}
+
+// Unwrap unwraps the WebhookEndpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WebhookEndpoint) Unwrap() *WebhookEndpoint {
+	tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("models: WebhookEndpoint is not a transactional entity")
+	}
+	we.config.driver = tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WebhookEndpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEndpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v", we.ID))
+	builder.WriteString(", owner=")
+	builder.WriteString(we.Owner)
+	builder.WriteString(", url=")
+	builder.WriteString(we.URL)
+	builder.WriteString(", secret=<sensitive>")
+	builder.WriteString(", created_at=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEndpoints is a parsable slice of WebhookEndpoint.
+type WebhookEndpoints []*WebhookEndpoint
+
+func (we WebhookEndpoints) config(cfg config) {
+	for _i := range we {
+		we[_i].config = cfg
+	}
+}
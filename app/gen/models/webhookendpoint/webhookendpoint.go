@@ -0,0 +1,48 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package webhookendpoint
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the webhookendpoint type in the database.
+	Label = "webhook_endpoint"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the webhookendpoint in the database.
+	Table = "webhook_endpoints"
+)
+
+// Columns holds all SQL columns for webhookendpoint fields.
+var Columns = []string{
+	FieldID,
+	FieldOwner,
+	FieldURL,
+	FieldSecret,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,260 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+)
+
+// WebhookEndpointCreate is the builder for creating a WebhookEndpoint entity.
+type WebhookEndpointCreate struct {
+	config
+	mutation *WebhookEndpointMutation
+	hooks    []Hook
+}
+
+// SetOwner sets the "owner" field.
+func (wec *WebhookEndpointCreate) SetOwner(s string) *WebhookEndpointCreate {
+	wec.mutation.SetOwner(s)
+	return wec
+}
+
+// SetURL sets the "url" field.
+func (wec *WebhookEndpointCreate) SetURL(s string) *WebhookEndpointCreate {
+	wec.mutation.SetURL(s)
+	return wec
+}
+
+// SetSecret sets the "secret" field.
+func (wec *WebhookEndpointCreate) SetSecret(s string) *WebhookEndpointCreate {
+	wec.mutation.SetSecret(s)
+	return wec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wec *WebhookEndpointCreate) SetCreatedAt(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetCreatedAt(t)
+	return wec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableCreatedAt(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetCreatedAt(*t)
+	}
+	return wec
+}
+
+// SetID sets the "id" field.
+func (wec *WebhookEndpointCreate) SetID(s string) *WebhookEndpointCreate {
+	wec.mutation.SetID(s)
+	return wec
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (wec *WebhookEndpointCreate) Mutation() *WebhookEndpointMutation {
+	return wec.mutation
+}
+
+// Save creates the WebhookEndpoint in the database.
+func (wec *WebhookEndpointCreate) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	var (
+		err  error
+		node *WebhookEndpoint
+	)
+	wec.defaults()
+	if len(wec.hooks) == 0 {
+		if err = wec.check(); err != nil {
+			return nil, err
+		}
+		node, err = wec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookEndpointMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wec.check(); err != nil {
+				return nil, err
+			}
+			wec.mutation = mutation
+			node, err = wec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wec.hooks) - 1; i >= 0; i-- {
+			mut = wec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WebhookEndpointCreate) SaveX(ctx context.Context) *WebhookEndpoint {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WebhookEndpointCreate) defaults() {
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		v := webhookendpoint.DefaultCreatedAt()
+		wec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WebhookEndpointCreate) check() error {
+	if _, ok := wec.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New("models: missing required field \"owner\"")}
+	}
+	if _, ok := wec.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New("models: missing required field \"url\"")}
+	}
+	if _, ok := wec.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New("models: missing required field \"secret\"")}
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	return nil
+}
+
+func (wec *WebhookEndpointCreate) sqlSave(ctx context.Context) (*WebhookEndpoint, error) {
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (wec *WebhookEndpointCreate) createSpec() (*WebhookEndpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEndpoint{config: wec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhookendpoint.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webhookendpoint.FieldID,
+			},
+		}
+	)
+	if id, ok := wec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wec.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := wec.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldURL,
+		})
+		_node.URL = value
+	}
+	if value, ok := wec.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldSecret,
+		})
+		_node.Secret = value
+	}
+	if value, ok := wec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookendpoint.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebhookEndpointCreateBulk is the builder for creating many WebhookEndpoint entities in bulk.
+type WebhookEndpointCreateBulk struct {
+	config
+	builders []*WebhookEndpointCreate
+}
+
+// Save creates the WebhookEndpoint entities in the database.
+func (wecb *WebhookEndpointCreateBulk) Save(ctx context.Context) ([]*WebhookEndpoint, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WebhookEndpoint, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEndpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WebhookEndpointCreateBulk) SaveX(ctx context.Context) []*WebhookEndpoint {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
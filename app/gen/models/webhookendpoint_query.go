@@ -0,0 +1,895 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+)
+
+// WebhookEndpointQuery is the builder for querying WebhookEndpoint entities.
+type WebhookEndpointQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WebhookEndpoint
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookEndpointQuery builder.
+func (weq *WebhookEndpointQuery) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointQuery {
+	weq.predicates = append(weq.predicates, ps...)
+	return weq
+}
+
+// Limit adds a limit step to the query.
+func (weq *WebhookEndpointQuery) Limit(limit int) *WebhookEndpointQuery {
+	weq.limit = &limit
+	return weq
+}
+
+// Offset adds an offset step to the query.
+func (weq *WebhookEndpointQuery) Offset(offset int) *WebhookEndpointQuery {
+	weq.offset = &offset
+	return weq
+}
+
+// Order adds an order step to the query.
+func (weq *WebhookEndpointQuery) Order(o ...OrderFunc) *WebhookEndpointQuery {
+	weq.order = append(weq.order, o...)
+	return weq
+}
+
+// First returns the first WebhookEndpoint entity from the query.
+// Returns a *NotFoundError when no WebhookEndpoint was found.
+func (weq *WebhookEndpointQuery) First(ctx context.Context) (*WebhookEndpoint, error) {
+	nodes, err := weq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookendpoint.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) FirstX(ctx context.Context) *WebhookEndpoint {
+	node, err := weq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookEndpoint ID from the query.
+// Returns a *NotFoundError when no WebhookEndpoint ID was found.
+func (weq *WebhookEndpointQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = weq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookendpoint.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) FirstIDX(ctx context.Context) string {
+	id, err := weq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookEndpoint entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one WebhookEndpoint entity is not found.
+// Returns a *NotFoundError when no WebhookEndpoint entities are found.
+func (weq *WebhookEndpointQuery) Only(ctx context.Context) (*WebhookEndpoint, error) {
+	nodes, err := weq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookendpoint.Label}
+	default:
+		return nil, &NotSingularError{webhookendpoint.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) OnlyX(ctx context.Context) *WebhookEndpoint {
+	node, err := weq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookEndpoint ID in the query.
+// Returns a *NotSingularError when exactly one WebhookEndpoint ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (weq *WebhookEndpointQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = weq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = &NotSingularError{webhookendpoint.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) OnlyIDX(ctx context.Context) string {
+	id, err := weq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookEndpoints.
+func (weq *WebhookEndpointQuery) All(ctx context.Context) ([]*WebhookEndpoint, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return weq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) AllX(ctx context.Context) []*WebhookEndpoint {
+	nodes, err := weq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookEndpoint IDs.
+func (weq *WebhookEndpointQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := weq.Select(webhookendpoint.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) IDsX(ctx context.Context) []string {
+	ids, err := weq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (weq *WebhookEndpointQuery) Count(ctx context.Context) (int, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return weq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) CountX(ctx context.Context) int {
+	count, err := weq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (weq *WebhookEndpointQuery) Exist(ctx context.Context) (bool, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return weq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) ExistX(ctx context.Context) bool {
+	exist, err := weq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookEndpointQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (weq *WebhookEndpointQuery) Clone() *WebhookEndpointQuery {
+	if weq == nil {
+		return nil
+	}
+	return &WebhookEndpointQuery{
+		config:     weq.config,
+		limit:      weq.limit,
+		offset:     weq.offset,
+		order:      append([]OrderFunc{}, weq.order...),
+		predicates: append([]predicate.WebhookEndpoint{}, weq.predicates...),
+		// clone intermediate query.
+		sql:  weq.sql.Clone(),
+		path: weq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookEndpoint.Query().
+//		GroupBy(webhookendpoint.FieldOwner).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (weq *WebhookEndpointQuery) GroupBy(field string, fields ...string) *WebhookEndpointGroupBy {
+	group := &WebhookEndpointGroupBy{config: weq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return weq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Owner string `json:"owner,omitempty"`
+//	}
+//
+//	client.WebhookEndpoint.Query().
+//		Select(webhookendpoint.FieldOwner).
+//		Scan(ctx, &v)
+//
+func (weq *WebhookEndpointQuery) Select(field string, fields ...string) *WebhookEndpointSelect {
+	weq.fields = append([]string{field}, fields...)
+	return &WebhookEndpointSelect{WebhookEndpointQuery: weq}
+}
+
+func (weq *WebhookEndpointQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range weq.fields {
+		if !webhookendpoint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if weq.path != nil {
+		prev, err := weq.path(ctx)
+		if err != nil {
+			return err
+		}
+		weq.sql = prev
+	}
+	return nil
+}
+
+func (weq *WebhookEndpointQuery) sqlAll(ctx context.Context) ([]*WebhookEndpoint, error) {
+	var (
+		nodes = []*WebhookEndpoint{}
+		_spec = weq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WebhookEndpoint{config: weq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, weq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (weq *WebhookEndpointQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := weq.querySpec()
+	return sqlgraph.CountNodes(ctx, weq.driver, _spec)
+}
+
+func (weq *WebhookEndpointQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := weq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (weq *WebhookEndpointQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookendpoint.Table,
+			Columns: webhookendpoint.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webhookendpoint.FieldID,
+			},
+		},
+		From:   weq.sql,
+		Unique: true,
+	}
+	if fields := weq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookendpoint.FieldID)
+		for i := range fields {
+			if fields[i] != webhookendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := weq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := weq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := weq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := weq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, webhookendpoint.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (weq *WebhookEndpointQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(weq.driver.Dialect())
+	t1 := builder.Table(webhookendpoint.Table)
+	selector := builder.Select(t1.Columns(webhookendpoint.Columns...)...).From(t1)
+	if weq.sql != nil {
+		selector = weq.sql
+		selector.Select(selector.Columns(webhookendpoint.Columns...)...)
+	}
+	for _, p := range weq.predicates {
+		p(selector)
+	}
+	for _, p := range weq.order {
+		p(selector, webhookendpoint.ValidColumn)
+	}
+	if offset := weq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := weq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookEndpointGroupBy is the group-by builder for WebhookEndpoint entities.
+type WebhookEndpointGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wegb *WebhookEndpointGroupBy) Aggregate(fns ...AggregateFunc) *WebhookEndpointGroupBy {
+	wegb.fns = append(wegb.fns, fns...)
+	return wegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wegb *WebhookEndpointGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wegb.sql = query
+	return wegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) StringX(ctx context.Context) string {
+	v, err := wegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) IntX(ctx context.Context) int {
+	v, err := wegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEndpointGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wegb *WebhookEndpointGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wegb *WebhookEndpointGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wegb.fields {
+		if !webhookendpoint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wegb *WebhookEndpointGroupBy) sqlQuery() *sql.Selector {
+	selector := wegb.sql
+	columns := make([]string, 0, len(wegb.fields)+len(wegb.fns))
+	columns = append(columns, wegb.fields...)
+	for _, fn := range wegb.fns {
+		columns = append(columns, fn(selector, webhookendpoint.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(wegb.fields...)
+}
+
+// WebhookEndpointSelect is the builder for selecting fields of WebhookEndpoint entities.
+type WebhookEndpointSelect struct {
+	*WebhookEndpointQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wes *WebhookEndpointSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wes.sql = wes.WebhookEndpointQuery.sqlQuery(ctx)
+	return wes.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wes.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) StringsX(ctx context.Context) []string {
+	v, err := wes.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wes.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) StringX(ctx context.Context) string {
+	v, err := wes.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) IntsX(ctx context.Context) []int {
+	v, err := wes.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wes.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) IntX(ctx context.Context) int {
+	v, err := wes.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wes.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wes.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) Float64X(ctx context.Context) float64 {
+	v, err := wes.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEndpointSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) BoolsX(ctx context.Context) []bool {
+	v, err := wes.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEndpointSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wes.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEndpointSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wes *WebhookEndpointSelect) BoolX(ctx context.Context) bool {
+	v, err := wes.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wes *WebhookEndpointSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wes.sqlQuery().Query()
+	if err := wes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wes *WebhookEndpointSelect) sqlQuery() sql.Querier {
+	selector := wes.sql
+	selector.Select(selector.Columns(wes.fields...)...)
+	return selector
+}
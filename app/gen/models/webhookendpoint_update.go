@@ -0,0 +1,290 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+)
+
+// WebhookEndpointUpdate is the builder for updating WebhookEndpoint entities.
+type WebhookEndpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// Where adds a new predicate for the WebhookEndpointUpdate builder.
+func (weu *WebhookEndpointUpdate) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdate {
+	weu.mutation.predicates = append(weu.mutation.predicates, ps...)
+	return weu
+}
+
+// SetOwner sets the "owner" field.
+func (weu *WebhookEndpointUpdate) SetOwner(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetOwner(s)
+	return weu
+}
+
+// SetURL sets the "url" field.
+func (weu *WebhookEndpointUpdate) SetURL(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetURL(s)
+	return weu
+}
+
+// SetSecret sets the "secret" field.
+func (weu *WebhookEndpointUpdate) SetSecret(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetSecret(s)
+	return weu
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (weu *WebhookEndpointUpdate) Mutation() *WebhookEndpointMutation {
+	return weu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WebhookEndpointUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(weu.hooks) == 0 {
+		affected, err = weu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookEndpointMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			weu.mutation = mutation
+			affected, err = weu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(weu.hooks) - 1; i >= 0; i-- {
+			mut = weu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, weu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WebhookEndpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WebhookEndpointUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WebhookEndpointUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (weu *WebhookEndpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookendpoint.Table,
+			Columns: webhookendpoint.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webhookendpoint.FieldID,
+			},
+		},
+	}
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldOwner,
+		})
+	}
+	if value, ok := weu.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldURL,
+		})
+	}
+	if value, ok := weu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldSecret,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookEndpointUpdateOne is the builder for updating a single WebhookEndpoint entity.
+type WebhookEndpointUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// SetOwner sets the "owner" field.
+func (weuo *WebhookEndpointUpdateOne) SetOwner(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetOwner(s)
+	return weuo
+}
+
+// SetURL sets the "url" field.
+func (weuo *WebhookEndpointUpdateOne) SetURL(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetURL(s)
+	return weuo
+}
+
+// SetSecret sets the "secret" field.
+func (weuo *WebhookEndpointUpdateOne) SetSecret(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetSecret(s)
+	return weuo
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (weuo *WebhookEndpointUpdateOne) Mutation() *WebhookEndpointMutation {
+	return weuo.mutation
+}
+
+// Save executes the query and returns the updated WebhookEndpoint entity.
+func (weuo *WebhookEndpointUpdateOne) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	var (
+		err  error
+		node *WebhookEndpoint
+	)
+	if len(weuo.hooks) == 0 {
+		node, err = weuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookEndpointMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			weuo.mutation = mutation
+			node, err = weuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(weuo.hooks) - 1; i >= 0; i-- {
+			mut = weuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, weuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WebhookEndpointUpdateOne) SaveX(ctx context.Context) *WebhookEndpoint {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WebhookEndpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WebhookEndpointUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (weuo *WebhookEndpointUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEndpoint, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookendpoint.Table,
+			Columns: webhookendpoint.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webhookendpoint.FieldID,
+			},
+		},
+	}
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WebhookEndpoint.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldOwner,
+		})
+	}
+	if value, ok := weuo.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldURL,
+		})
+	}
+	if value, ok := weuo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookendpoint.FieldSecret,
+		})
+	}
+	_node = &WebhookEndpoint{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
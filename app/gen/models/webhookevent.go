@@ -0,0 +1,203 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+// WebhookEvent is the model entity for the WebhookEvent schema.
+type WebhookEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID string `json:"event_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// ProcessedAt holds the value of the "processed_at" field.
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookevent.FieldPayload:
+			values[i] = &[]byte{}
+		case webhookevent.FieldID, webhookevent.FieldAttempts:
+			values[i] = &sql.NullInt64{}
+		case webhookevent.FieldSource, webhookevent.FieldEventID, webhookevent.FieldType, webhookevent.FieldError:
+			values[i] = &sql.NullString{}
+		case webhookevent.FieldReceivedAt, webhookevent.FieldProcessedAt, webhookevent.FieldNextAttemptAt, webhookevent.FieldClaimedAt:
+			values[i] = &sql.NullTime{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebhookEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEvent fields.
+func (we *WebhookEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			we.ID = int(value.Int64)
+		case webhookevent.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				we.Source = value.String
+			}
+		case webhookevent.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				we.EventID = value.String
+			}
+		case webhookevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				we.Type = value.String
+			}
+		case webhookevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				we.Payload = *value
+			}
+		case webhookevent.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				we.ReceivedAt = value.Time
+			}
+		case webhookevent.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				we.ProcessedAt = new(time.Time)
+				*we.ProcessedAt = value.Time
+			}
+		case webhookevent.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				we.Error = value.String
+			}
+		case webhookevent.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				we.Attempts = int(value.Int64)
+			}
+		case webhookevent.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				we.NextAttemptAt = new(time.Time)
+				*we.NextAttemptAt = value.Time
+			}
+		case webhookevent.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				we.ClaimedAt = new(time.Time)
+				*we.ClaimedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WebhookEvent.
+// Note that you need to call WebhookEvent.Unwrap() before calling this method if this WebhookEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WebhookEvent) Update() *WebhookEventUpdateOne {
+	return (&WebhookEventClient{config: we.config}).UpdateOne(we)
+}
+
+// Unwrap unwraps the WebhookEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WebhookEvent) Unwrap() *WebhookEvent {
+	tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("models: WebhookEvent is not a transactional entity")
+	}
+	we.config.driver = tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WebhookEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v", we.ID))
+	builder.WriteString(", source=")
+	builder.WriteString(we.Source)
+	builder.WriteString(", event_id=")
+	builder.WriteString(we.EventID)
+	builder.WriteString(", type=")
+	builder.WriteString(we.Type)
+	builder.WriteString(", payload=")
+	builder.WriteString(fmt.Sprintf("%v", we.Payload))
+	builder.WriteString(", received_at=")
+	builder.WriteString(we.ReceivedAt.Format(time.ANSIC))
+	if v := we.ProcessedAt; v != nil {
+		builder.WriteString(", processed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", error=")
+	builder.WriteString(we.Error)
+	builder.WriteString(", attempts=")
+	builder.WriteString(fmt.Sprintf("%v", we.Attempts))
+	if v := we.NextAttemptAt; v != nil {
+		builder.WriteString(", next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := we.ClaimedAt; v != nil {
+		builder.WriteString(", claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEvents is a parsable slice of WebhookEvent.
+type WebhookEvents []*WebhookEvent
+
+func (we WebhookEvents) config(cfg config) {
+	for _i := range we {
+		we[_i].config = cfg
+	}
+}
@@ -0,0 +1,68 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package webhookevent
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the webhookevent type in the database.
+	Label = "webhook_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldProcessedAt holds the string denoting the processed_at field in the database.
+	FieldProcessedAt = "processed_at"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldClaimedAt holds the string denoting the claimed_at field in the database.
+	FieldClaimedAt = "claimed_at"
+	// Table holds the table name of the webhookevent in the database.
+	Table = "webhook_events"
+)
+
+// Columns holds all SQL columns for webhookevent fields.
+var Columns = []string{
+	FieldID,
+	FieldSource,
+	FieldEventID,
+	FieldType,
+	FieldPayload,
+	FieldReceivedAt,
+	FieldProcessedAt,
+	FieldError,
+	FieldAttempts,
+	FieldNextAttemptAt,
+	FieldClaimedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+)
@@ -0,0 +1,1151 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package webhookevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventID), v))
+	})
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPayload), v))
+	})
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ProcessedAt applies equality check predicate on the "processed_at" field. It's identical to ProcessedAtEQ.
+func ProcessedAt(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProcessedAt), v))
+	})
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldError), v))
+	})
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimedAt), v))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEventID), v...))
+	})
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEventID), v...))
+	})
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEventID), v))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), v))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), v))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), v))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), v))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), v))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), v))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), v))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), v))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), v))
+	})
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...[]byte) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPayload), v...))
+	})
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...[]byte) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPayload), v...))
+	})
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPayload), v))
+	})
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v []byte) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPayload), v))
+	})
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReceivedAt), v...))
+	})
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReceivedAt), v...))
+	})
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ProcessedAtEQ applies the EQ predicate on the "processed_at" field.
+func ProcessedAtEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProcessedAt), v))
+	})
+}
+
+// ProcessedAtNEQ applies the NEQ predicate on the "processed_at" field.
+func ProcessedAtNEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProcessedAt), v))
+	})
+}
+
+// ProcessedAtIn applies the In predicate on the "processed_at" field.
+func ProcessedAtIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProcessedAt), v...))
+	})
+}
+
+// ProcessedAtNotIn applies the NotIn predicate on the "processed_at" field.
+func ProcessedAtNotIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProcessedAt), v...))
+	})
+}
+
+// ProcessedAtGT applies the GT predicate on the "processed_at" field.
+func ProcessedAtGT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProcessedAt), v))
+	})
+}
+
+// ProcessedAtGTE applies the GTE predicate on the "processed_at" field.
+func ProcessedAtGTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProcessedAt), v))
+	})
+}
+
+// ProcessedAtLT applies the LT predicate on the "processed_at" field.
+func ProcessedAtLT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProcessedAt), v))
+	})
+}
+
+// ProcessedAtLTE applies the LTE predicate on the "processed_at" field.
+func ProcessedAtLTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProcessedAt), v))
+	})
+}
+
+// ProcessedAtIsNil applies the IsNil predicate on the "processed_at" field.
+func ProcessedAtIsNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProcessedAt)))
+	})
+}
+
+// ProcessedAtNotNil applies the NotNil predicate on the "processed_at" field.
+func ProcessedAtNotNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProcessedAt)))
+	})
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldError), v))
+	})
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldError), v))
+	})
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldError), v...))
+	})
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldError), v...))
+	})
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldError), v))
+	})
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldError), v))
+	})
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldError), v))
+	})
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldError), v))
+	})
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldError), v))
+	})
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldError), v))
+	})
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldError), v))
+	})
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldError)))
+	})
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldError)))
+	})
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldError), v))
+	})
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldError), v))
+	})
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempts), v))
+	})
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNextAttemptAt), v...))
+	})
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNextAttemptAt), v...))
+	})
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNextAttemptAt), v))
+	})
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNextAttemptAt)))
+	})
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNextAttemptAt)))
+	})
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimedAt), v))
+	})
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimedAt), v))
+	})
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClaimedAt), v...))
+	})
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.WebhookEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClaimedAt), v...))
+	})
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimedAt), v))
+	})
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimedAt), v))
+	})
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimedAt), v))
+	})
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimedAt), v))
+	})
+}
+
+// ClaimedAtIsNil applies the IsNil predicate on the "claimed_at" field.
+func ClaimedAtIsNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClaimedAt)))
+	})
+}
+
+// ClaimedAtNotNil applies the NotNil predicate on the "claimed_at" field.
+func ClaimedAtNotNil() predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClaimedAt)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebhookEvent) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebhookEvent) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebhookEvent) predicate.WebhookEvent {
+	return predicate.WebhookEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,388 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+// WebhookEventCreate is the builder for creating a WebhookEvent entity.
+type WebhookEventCreate struct {
+	config
+	mutation *WebhookEventMutation
+	hooks    []Hook
+}
+
+// SetSource sets the "source" field.
+func (wec *WebhookEventCreate) SetSource(s string) *WebhookEventCreate {
+	wec.mutation.SetSource(s)
+	return wec
+}
+
+// SetEventID sets the "event_id" field.
+func (wec *WebhookEventCreate) SetEventID(s string) *WebhookEventCreate {
+	wec.mutation.SetEventID(s)
+	return wec
+}
+
+// SetType sets the "type" field.
+func (wec *WebhookEventCreate) SetType(s string) *WebhookEventCreate {
+	wec.mutation.SetType(s)
+	return wec
+}
+
+// SetPayload sets the "payload" field.
+func (wec *WebhookEventCreate) SetPayload(b []byte) *WebhookEventCreate {
+	wec.mutation.SetPayload(b)
+	return wec
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (wec *WebhookEventCreate) SetReceivedAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetReceivedAt(t)
+	return wec
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableReceivedAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetReceivedAt(*t)
+	}
+	return wec
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (wec *WebhookEventCreate) SetProcessedAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetProcessedAt(t)
+	return wec
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableProcessedAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetProcessedAt(*t)
+	}
+	return wec
+}
+
+// SetError sets the "error" field.
+func (wec *WebhookEventCreate) SetError(s string) *WebhookEventCreate {
+	wec.mutation.SetError(s)
+	return wec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableError(s *string) *WebhookEventCreate {
+	if s != nil {
+		wec.SetError(*s)
+	}
+	return wec
+}
+
+// SetAttempts sets the "attempts" field.
+func (wec *WebhookEventCreate) SetAttempts(i int) *WebhookEventCreate {
+	wec.mutation.SetAttempts(i)
+	return wec
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableAttempts(i *int) *WebhookEventCreate {
+	if i != nil {
+		wec.SetAttempts(*i)
+	}
+	return wec
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wec *WebhookEventCreate) SetNextAttemptAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetNextAttemptAt(t)
+	return wec
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableNextAttemptAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetNextAttemptAt(*t)
+	}
+	return wec
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (wec *WebhookEventCreate) SetClaimedAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetClaimedAt(t)
+	return wec
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableClaimedAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetClaimedAt(*t)
+	}
+	return wec
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (wec *WebhookEventCreate) Mutation() *WebhookEventMutation {
+	return wec.mutation
+}
+
+// Save creates the WebhookEvent in the database.
+func (wec *WebhookEventCreate) Save(ctx context.Context) (*WebhookEvent, error) {
+	var (
+		err  error
+		node *WebhookEvent
+	)
+	wec.defaults()
+	if len(wec.hooks) == 0 {
+		if err = wec.check(); err != nil {
+			return nil, err
+		}
+		node, err = wec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wec.check(); err != nil {
+				return nil, err
+			}
+			wec.mutation = mutation
+			node, err = wec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wec.hooks) - 1; i >= 0; i-- {
+			mut = wec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WebhookEventCreate) SaveX(ctx context.Context) *WebhookEvent {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WebhookEventCreate) defaults() {
+	if _, ok := wec.mutation.ReceivedAt(); !ok {
+		v := webhookevent.DefaultReceivedAt()
+		wec.mutation.SetReceivedAt(v)
+	}
+	if _, ok := wec.mutation.Attempts(); !ok {
+		v := webhookevent.DefaultAttempts
+		wec.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WebhookEventCreate) check() error {
+	if _, ok := wec.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New("models: missing required field \"source\"")}
+	}
+	if _, ok := wec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New("models: missing required field \"event_id\"")}
+	}
+	if _, ok := wec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New("models: missing required field \"type\"")}
+	}
+	if _, ok := wec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New("models: missing required field \"payload\"")}
+	}
+	if _, ok := wec.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New("models: missing required field \"received_at\"")}
+	}
+	if _, ok := wec.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New("models: missing required field \"attempts\"")}
+	}
+	return nil
+}
+
+func (wec *WebhookEventCreate) sqlSave(ctx context.Context) (*WebhookEvent, error) {
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wec *WebhookEventCreate) createSpec() (*WebhookEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEvent{config: wec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhookevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookevent.FieldID,
+			},
+		}
+	)
+	if value, ok := wec.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := wec.mutation.EventID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldEventID,
+		})
+		_node.EventID = value
+	}
+	if value, ok := wec.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := wec.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webhookevent.FieldPayload,
+		})
+		_node.Payload = value
+	}
+	if value, ok := wec.mutation.ReceivedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldReceivedAt,
+		})
+		_node.ReceivedAt = value
+	}
+	if value, ok := wec.mutation.ProcessedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldProcessedAt,
+		})
+		_node.ProcessedAt = &value
+	}
+	if value, ok := wec.mutation.Error(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldError,
+		})
+		_node.Error = value
+	}
+	if value, ok := wec.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookevent.FieldAttempts,
+		})
+		_node.Attempts = value
+	}
+	if value, ok := wec.mutation.NextAttemptAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldNextAttemptAt,
+		})
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := wec.mutation.ClaimedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldClaimedAt,
+		})
+		_node.ClaimedAt = &value
+	}
+	return _node, _spec
+}
+
+// WebhookEventCreateBulk is the builder for creating many WebhookEvent entities in bulk.
+type WebhookEventCreateBulk struct {
+	config
+	builders []*WebhookEventCreate
+}
+
+// Save creates the WebhookEvent entities in the database.
+func (wecb *WebhookEventCreateBulk) Save(ctx context.Context) ([]*WebhookEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WebhookEvent, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WebhookEventCreateBulk) SaveX(ctx context.Context) []*WebhookEvent {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,895 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+// WebhookEventQuery is the builder for querying WebhookEvent entities.
+type WebhookEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WebhookEvent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookEventQuery builder.
+func (weq *WebhookEventQuery) Where(ps ...predicate.WebhookEvent) *WebhookEventQuery {
+	weq.predicates = append(weq.predicates, ps...)
+	return weq
+}
+
+// Limit adds a limit step to the query.
+func (weq *WebhookEventQuery) Limit(limit int) *WebhookEventQuery {
+	weq.limit = &limit
+	return weq
+}
+
+// Offset adds an offset step to the query.
+func (weq *WebhookEventQuery) Offset(offset int) *WebhookEventQuery {
+	weq.offset = &offset
+	return weq
+}
+
+// Order adds an order step to the query.
+func (weq *WebhookEventQuery) Order(o ...OrderFunc) *WebhookEventQuery {
+	weq.order = append(weq.order, o...)
+	return weq
+}
+
+// First returns the first WebhookEvent entity from the query.
+// Returns a *NotFoundError when no WebhookEvent was found.
+func (weq *WebhookEventQuery) First(ctx context.Context) (*WebhookEvent, error) {
+	nodes, err := weq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (weq *WebhookEventQuery) FirstX(ctx context.Context) *WebhookEvent {
+	node, err := weq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookEvent ID from the query.
+// Returns a *NotFoundError when no WebhookEvent ID was found.
+func (weq *WebhookEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = weq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (weq *WebhookEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := weq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one WebhookEvent entity is not found.
+// Returns a *NotFoundError when no WebhookEvent entities are found.
+func (weq *WebhookEventQuery) Only(ctx context.Context) (*WebhookEvent, error) {
+	nodes, err := weq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookevent.Label}
+	default:
+		return nil, &NotSingularError{webhookevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (weq *WebhookEventQuery) OnlyX(ctx context.Context) *WebhookEvent {
+	node, err := weq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookEvent ID in the query.
+// Returns a *NotSingularError when exactly one WebhookEvent ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (weq *WebhookEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = weq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = &NotSingularError{webhookevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (weq *WebhookEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := weq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookEvents.
+func (weq *WebhookEventQuery) All(ctx context.Context) ([]*WebhookEvent, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return weq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (weq *WebhookEventQuery) AllX(ctx context.Context) []*WebhookEvent {
+	nodes, err := weq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookEvent IDs.
+func (weq *WebhookEventQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := weq.Select(webhookevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (weq *WebhookEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := weq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (weq *WebhookEventQuery) Count(ctx context.Context) (int, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return weq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (weq *WebhookEventQuery) CountX(ctx context.Context) int {
+	count, err := weq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (weq *WebhookEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return weq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (weq *WebhookEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := weq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (weq *WebhookEventQuery) Clone() *WebhookEventQuery {
+	if weq == nil {
+		return nil
+	}
+	return &WebhookEventQuery{
+		config:     weq.config,
+		limit:      weq.limit,
+		offset:     weq.offset,
+		order:      append([]OrderFunc{}, weq.order...),
+		predicates: append([]predicate.WebhookEvent{}, weq.predicates...),
+		// clone intermediate query.
+		sql:  weq.sql.Clone(),
+		path: weq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Source string `json:"source,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookEvent.Query().
+//		GroupBy(webhookevent.FieldSource).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+//
+func (weq *WebhookEventQuery) GroupBy(field string, fields ...string) *WebhookEventGroupBy {
+	group := &WebhookEventGroupBy{config: weq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return weq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Source string `json:"source,omitempty"`
+//	}
+//
+//	client.WebhookEvent.Query().
+//		Select(webhookevent.FieldSource).
+//		Scan(ctx, &v)
+//
+func (weq *WebhookEventQuery) Select(field string, fields ...string) *WebhookEventSelect {
+	weq.fields = append([]string{field}, fields...)
+	return &WebhookEventSelect{WebhookEventQuery: weq}
+}
+
+func (weq *WebhookEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range weq.fields {
+		if !webhookevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if weq.path != nil {
+		prev, err := weq.path(ctx)
+		if err != nil {
+			return err
+		}
+		weq.sql = prev
+	}
+	return nil
+}
+
+func (weq *WebhookEventQuery) sqlAll(ctx context.Context) ([]*WebhookEvent, error) {
+	var (
+		nodes = []*WebhookEvent{}
+		_spec = weq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WebhookEvent{config: weq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("models: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, weq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (weq *WebhookEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := weq.querySpec()
+	return sqlgraph.CountNodes(ctx, weq.driver, _spec)
+}
+
+func (weq *WebhookEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := weq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("models: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (weq *WebhookEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookevent.Table,
+			Columns: webhookevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookevent.FieldID,
+			},
+		},
+		From:   weq.sql,
+		Unique: true,
+	}
+	if fields := weq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookevent.FieldID)
+		for i := range fields {
+			if fields[i] != webhookevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := weq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := weq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := weq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := weq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, webhookevent.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (weq *WebhookEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(weq.driver.Dialect())
+	t1 := builder.Table(webhookevent.Table)
+	selector := builder.Select(t1.Columns(webhookevent.Columns...)...).From(t1)
+	if weq.sql != nil {
+		selector = weq.sql
+		selector.Select(selector.Columns(webhookevent.Columns...)...)
+	}
+	for _, p := range weq.predicates {
+		p(selector)
+	}
+	for _, p := range weq.order {
+		p(selector, webhookevent.ValidColumn)
+	}
+	if offset := weq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := weq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookEventGroupBy is the group-by builder for WebhookEvent entities.
+type WebhookEventGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wegb *WebhookEventGroupBy) Aggregate(fns ...AggregateFunc) *WebhookEventGroupBy {
+	wegb.fns = append(wegb.fns, fns...)
+	return wegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wegb *WebhookEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wegb.sql = query
+	return wegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEventGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) StringX(ctx context.Context) string {
+	v, err := wegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEventGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) IntX(ctx context.Context) int {
+	v, err := wegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEventGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("models: WebhookEventGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WebhookEventGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wegb *WebhookEventGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wegb *WebhookEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wegb.fields {
+		if !webhookevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wegb *WebhookEventGroupBy) sqlQuery() *sql.Selector {
+	selector := wegb.sql
+	columns := make([]string, 0, len(wegb.fields)+len(wegb.fns))
+	columns = append(columns, wegb.fields...)
+	for _, fn := range wegb.fns {
+		columns = append(columns, fn(selector, webhookevent.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(wegb.fields...)
+}
+
+// WebhookEventSelect is the builder for selecting fields of WebhookEvent entities.
+type WebhookEventSelect struct {
+	*WebhookEventQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wes *WebhookEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wes.sql = wes.WebhookEventQuery.sqlQuery(ctx)
+	return wes.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wes *WebhookEventSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wes.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEventSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wes *WebhookEventSelect) StringsX(ctx context.Context) []string {
+	v, err := wes.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wes.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wes *WebhookEventSelect) StringX(ctx context.Context) string {
+	v, err := wes.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEventSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wes *WebhookEventSelect) IntsX(ctx context.Context) []int {
+	v, err := wes.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wes.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wes *WebhookEventSelect) IntX(ctx context.Context) int {
+	v, err := wes.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEventSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wes *WebhookEventSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wes.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wes.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wes *WebhookEventSelect) Float64X(ctx context.Context) float64 {
+	v, err := wes.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("models: WebhookEventSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wes *WebhookEventSelect) BoolsX(ctx context.Context) []bool {
+	v, err := wes.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wes *WebhookEventSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wes.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookevent.Label}
+	default:
+		err = fmt.Errorf("models: WebhookEventSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wes *WebhookEventSelect) BoolX(ctx context.Context) bool {
+	v, err := wes.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wes *WebhookEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wes.sqlQuery().Query()
+	if err := wes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wes *WebhookEventSelect) sqlQuery() sql.Querier {
+	selector := wes.sql
+	selector.Select(selector.Columns(wes.fields...)...)
+	return selector
+}
@@ -0,0 +1,651 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+// WebhookEventUpdate is the builder for updating WebhookEvent entities.
+type WebhookEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// Where adds a new predicate for the WebhookEventUpdate builder.
+func (weu *WebhookEventUpdate) Where(ps ...predicate.WebhookEvent) *WebhookEventUpdate {
+	weu.mutation.predicates = append(weu.mutation.predicates, ps...)
+	return weu
+}
+
+// SetSource sets the "source" field.
+func (weu *WebhookEventUpdate) SetSource(s string) *WebhookEventUpdate {
+	weu.mutation.SetSource(s)
+	return weu
+}
+
+// SetEventID sets the "event_id" field.
+func (weu *WebhookEventUpdate) SetEventID(s string) *WebhookEventUpdate {
+	weu.mutation.SetEventID(s)
+	return weu
+}
+
+// SetType sets the "type" field.
+func (weu *WebhookEventUpdate) SetType(s string) *WebhookEventUpdate {
+	weu.mutation.SetType(s)
+	return weu
+}
+
+// SetPayload sets the "payload" field.
+func (weu *WebhookEventUpdate) SetPayload(b []byte) *WebhookEventUpdate {
+	weu.mutation.SetPayload(b)
+	return weu
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (weu *WebhookEventUpdate) SetProcessedAt(t time.Time) *WebhookEventUpdate {
+	weu.mutation.SetProcessedAt(t)
+	return weu
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableProcessedAt(t *time.Time) *WebhookEventUpdate {
+	if t != nil {
+		weu.SetProcessedAt(*t)
+	}
+	return weu
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (weu *WebhookEventUpdate) ClearProcessedAt() *WebhookEventUpdate {
+	weu.mutation.ClearProcessedAt()
+	return weu
+}
+
+// SetError sets the "error" field.
+func (weu *WebhookEventUpdate) SetError(s string) *WebhookEventUpdate {
+	weu.mutation.SetError(s)
+	return weu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableError(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetError(*s)
+	}
+	return weu
+}
+
+// ClearError clears the value of the "error" field.
+func (weu *WebhookEventUpdate) ClearError() *WebhookEventUpdate {
+	weu.mutation.ClearError()
+	return weu
+}
+
+// SetAttempts sets the "attempts" field.
+func (weu *WebhookEventUpdate) SetAttempts(i int) *WebhookEventUpdate {
+	weu.mutation.ResetAttempts()
+	weu.mutation.SetAttempts(i)
+	return weu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableAttempts(i *int) *WebhookEventUpdate {
+	if i != nil {
+		weu.SetAttempts(*i)
+	}
+	return weu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (weu *WebhookEventUpdate) AddAttempts(i int) *WebhookEventUpdate {
+	weu.mutation.AddAttempts(i)
+	return weu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (weu *WebhookEventUpdate) SetNextAttemptAt(t time.Time) *WebhookEventUpdate {
+	weu.mutation.SetNextAttemptAt(t)
+	return weu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookEventUpdate {
+	if t != nil {
+		weu.SetNextAttemptAt(*t)
+	}
+	return weu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (weu *WebhookEventUpdate) ClearNextAttemptAt() *WebhookEventUpdate {
+	weu.mutation.ClearNextAttemptAt()
+	return weu
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (weu *WebhookEventUpdate) SetClaimedAt(t time.Time) *WebhookEventUpdate {
+	weu.mutation.SetClaimedAt(t)
+	return weu
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableClaimedAt(t *time.Time) *WebhookEventUpdate {
+	if t != nil {
+		weu.SetClaimedAt(*t)
+	}
+	return weu
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (weu *WebhookEventUpdate) ClearClaimedAt() *WebhookEventUpdate {
+	weu.mutation.ClearClaimedAt()
+	return weu
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (weu *WebhookEventUpdate) Mutation() *WebhookEventMutation {
+	return weu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WebhookEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(weu.hooks) == 0 {
+		affected, err = weu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			weu.mutation = mutation
+			affected, err = weu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(weu.hooks) - 1; i >= 0; i-- {
+			mut = weu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, weu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WebhookEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WebhookEventUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WebhookEventUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (weu *WebhookEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookevent.Table,
+			Columns: webhookevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookevent.FieldID,
+			},
+		},
+	}
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldSource,
+		})
+	}
+	if value, ok := weu.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldEventID,
+		})
+	}
+	if value, ok := weu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldType,
+		})
+	}
+	if value, ok := weu.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webhookevent.FieldPayload,
+		})
+	}
+	if value, ok := weu.mutation.ProcessedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldProcessedAt,
+		})
+	}
+	if weu.mutation.ProcessedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookevent.FieldProcessedAt,
+		})
+	}
+	if value, ok := weu.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldError,
+		})
+	}
+	if weu.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookevent.FieldError,
+		})
+	}
+	if value, ok := weu.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookevent.FieldAttempts,
+		})
+	}
+	if value, ok := weu.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookevent.FieldAttempts,
+		})
+	}
+	if value, ok := weu.mutation.NextAttemptAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldNextAttemptAt,
+		})
+	}
+	if weu.mutation.NextAttemptAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookevent.FieldNextAttemptAt,
+		})
+	}
+	if value, ok := weu.mutation.ClaimedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldClaimedAt,
+		})
+	}
+	if weu.mutation.ClaimedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookevent.FieldClaimedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookevent.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookEventUpdateOne is the builder for updating a single WebhookEvent entity.
+type WebhookEventUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// SetSource sets the "source" field.
+func (weuo *WebhookEventUpdateOne) SetSource(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetSource(s)
+	return weuo
+}
+
+// SetEventID sets the "event_id" field.
+func (weuo *WebhookEventUpdateOne) SetEventID(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetEventID(s)
+	return weuo
+}
+
+// SetType sets the "type" field.
+func (weuo *WebhookEventUpdateOne) SetType(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetType(s)
+	return weuo
+}
+
+// SetPayload sets the "payload" field.
+func (weuo *WebhookEventUpdateOne) SetPayload(b []byte) *WebhookEventUpdateOne {
+	weuo.mutation.SetPayload(b)
+	return weuo
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (weuo *WebhookEventUpdateOne) SetProcessedAt(t time.Time) *WebhookEventUpdateOne {
+	weuo.mutation.SetProcessedAt(t)
+	return weuo
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableProcessedAt(t *time.Time) *WebhookEventUpdateOne {
+	if t != nil {
+		weuo.SetProcessedAt(*t)
+	}
+	return weuo
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (weuo *WebhookEventUpdateOne) ClearProcessedAt() *WebhookEventUpdateOne {
+	weuo.mutation.ClearProcessedAt()
+	return weuo
+}
+
+// SetError sets the "error" field.
+func (weuo *WebhookEventUpdateOne) SetError(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetError(s)
+	return weuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableError(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetError(*s)
+	}
+	return weuo
+}
+
+// ClearError clears the value of the "error" field.
+func (weuo *WebhookEventUpdateOne) ClearError() *WebhookEventUpdateOne {
+	weuo.mutation.ClearError()
+	return weuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (weuo *WebhookEventUpdateOne) SetAttempts(i int) *WebhookEventUpdateOne {
+	weuo.mutation.ResetAttempts()
+	weuo.mutation.SetAttempts(i)
+	return weuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableAttempts(i *int) *WebhookEventUpdateOne {
+	if i != nil {
+		weuo.SetAttempts(*i)
+	}
+	return weuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (weuo *WebhookEventUpdateOne) AddAttempts(i int) *WebhookEventUpdateOne {
+	weuo.mutation.AddAttempts(i)
+	return weuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (weuo *WebhookEventUpdateOne) SetNextAttemptAt(t time.Time) *WebhookEventUpdateOne {
+	weuo.mutation.SetNextAttemptAt(t)
+	return weuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookEventUpdateOne {
+	if t != nil {
+		weuo.SetNextAttemptAt(*t)
+	}
+	return weuo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (weuo *WebhookEventUpdateOne) ClearNextAttemptAt() *WebhookEventUpdateOne {
+	weuo.mutation.ClearNextAttemptAt()
+	return weuo
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (weuo *WebhookEventUpdateOne) SetClaimedAt(t time.Time) *WebhookEventUpdateOne {
+	weuo.mutation.SetClaimedAt(t)
+	return weuo
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableClaimedAt(t *time.Time) *WebhookEventUpdateOne {
+	if t != nil {
+		weuo.SetClaimedAt(*t)
+	}
+	return weuo
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (weuo *WebhookEventUpdateOne) ClearClaimedAt() *WebhookEventUpdateOne {
+	weuo.mutation.ClearClaimedAt()
+	return weuo
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (weuo *WebhookEventUpdateOne) Mutation() *WebhookEventMutation {
+	return weuo.mutation
+}
+
+// Save executes the query and returns the updated WebhookEvent entity.
+func (weuo *WebhookEventUpdateOne) Save(ctx context.Context) (*WebhookEvent, error) {
+	var (
+		err  error
+		node *WebhookEvent
+	)
+	if len(weuo.hooks) == 0 {
+		node, err = weuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			weuo.mutation = mutation
+			node, err = weuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(weuo.hooks) - 1; i >= 0; i-- {
+			mut = weuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, weuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WebhookEventUpdateOne) SaveX(ctx context.Context) *WebhookEvent {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WebhookEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WebhookEventUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (weuo *WebhookEventUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookevent.Table,
+			Columns: webhookevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookevent.FieldID,
+			},
+		},
+	}
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WebhookEvent.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldSource,
+		})
+	}
+	if value, ok := weuo.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldEventID,
+		})
+	}
+	if value, ok := weuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldType,
+		})
+	}
+	if value, ok := weuo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webhookevent.FieldPayload,
+		})
+	}
+	if value, ok := weuo.mutation.ProcessedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldProcessedAt,
+		})
+	}
+	if weuo.mutation.ProcessedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookevent.FieldProcessedAt,
+		})
+	}
+	if value, ok := weuo.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookevent.FieldError,
+		})
+	}
+	if weuo.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookevent.FieldError,
+		})
+	}
+	if value, ok := weuo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookevent.FieldAttempts,
+		})
+	}
+	if value, ok := weuo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookevent.FieldAttempts,
+		})
+	}
+	if value, ok := weuo.mutation.NextAttemptAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldNextAttemptAt,
+		})
+	}
+	if weuo.mutation.NextAttemptAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookevent.FieldNextAttemptAt,
+		})
+	}
+	if value, ok := weuo.mutation.ClaimedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookevent.FieldClaimedAt,
+		})
+	}
+	if weuo.mutation.ClaimedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookevent.FieldClaimedAt,
+		})
+	}
+	_node = &WebhookEvent{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookevent.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
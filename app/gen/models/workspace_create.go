@@ -0,0 +1,353 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// WorkspaceCreate is the builder for creating a Workspace entity.
+type WorkspaceCreate struct {
+	config
+	mutation *WorkspaceMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wc *WorkspaceCreate) SetName(s string) *WorkspaceCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WorkspaceCreate) SetCreatedAt(t time.Time) *WorkspaceCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WorkspaceCreate) SetNillableCreatedAt(t *time.Time) *WorkspaceCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (wc *WorkspaceCreate) SetLockedAt(t time.Time) *WorkspaceCreate {
+	wc.mutation.SetLockedAt(t)
+	return wc
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (wc *WorkspaceCreate) SetNillableLockedAt(t *time.Time) *WorkspaceCreate {
+	if t != nil {
+		wc.SetLockedAt(*t)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WorkspaceCreate) SetID(s string) *WorkspaceCreate {
+	wc.mutation.SetID(s)
+	return wc
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (wc *WorkspaceCreate) AddMembershipIDs(ids ...string) *WorkspaceCreate {
+	wc.mutation.AddMembershipIDs(ids...)
+	return wc
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (wc *WorkspaceCreate) AddMemberships(m ...*Membership) *WorkspaceCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return wc.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (wc *WorkspaceCreate) AddInvitationIDs(ids ...string) *WorkspaceCreate {
+	wc.mutation.AddInvitationIDs(ids...)
+	return wc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (wc *WorkspaceCreate) AddInvitations(i ...*Invitation) *WorkspaceCreate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wc.AddInvitationIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (wc *WorkspaceCreate) AddGroupIDs(ids ...string) *WorkspaceCreate {
+	wc.mutation.AddGroupIDs(ids...)
+	return wc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (wc *WorkspaceCreate) AddGroups(g ...*Group) *WorkspaceCreate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return wc.AddGroupIDs(ids...)
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (wc *WorkspaceCreate) Mutation() *WorkspaceMutation {
+	return wc.mutation
+}
+
+// Save creates the Workspace in the database.
+func (wc *WorkspaceCreate) Save(ctx context.Context) (*Workspace, error) {
+	var (
+		err  error
+		node *Workspace
+	)
+	wc.defaults()
+	if len(wc.hooks) == 0 {
+		if err = wc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WorkspaceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wc.check(); err != nil {
+				return nil, err
+			}
+			wc.mutation = mutation
+			node, err = wc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wc.hooks) - 1; i >= 0; i-- {
+			mut = wc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WorkspaceCreate) SaveX(ctx context.Context) *Workspace {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WorkspaceCreate) defaults() {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := workspace.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WorkspaceCreate) check() error {
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("models: missing required field \"name\"")}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("models: missing required field \"created_at\"")}
+	}
+	return nil
+}
+
+func (wc *WorkspaceCreate) sqlSave(ctx context.Context) (*Workspace, error) {
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (wc *WorkspaceCreate) createSpec() (*Workspace, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Workspace{config: wc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: workspace.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: workspace.FieldID,
+			},
+		}
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workspace.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: workspace.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.LockedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: workspace.FieldLockedAt,
+		})
+		_node.LockedAt = &value
+	}
+	if nodes := wc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkspaceCreateBulk is the builder for creating many Workspace entities in bulk.
+type WorkspaceCreateBulk struct {
+	config
+	builders []*WorkspaceCreate
+}
+
+// Save creates the Workspace entities in the database.
+func (wcb *WorkspaceCreateBulk) Save(ctx context.Context) ([]*Workspace, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Workspace, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkspaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WorkspaceCreateBulk) SaveX(ctx context.Context) []*Workspace {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
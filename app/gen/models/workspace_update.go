@@ -0,0 +1,848 @@
+// Code generated (@generated) by entc, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// WorkspaceUpdate is the builder for updating Workspace entities.
+type WorkspaceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkspaceMutation
+}
+
+// Where adds a new predicate for the WorkspaceUpdate builder.
+func (wu *WorkspaceUpdate) Where(ps ...predicate.Workspace) *WorkspaceUpdate {
+	wu.mutation.predicates = append(wu.mutation.predicates, ps...)
+	return wu
+}
+
+// SetName sets the "name" field.
+func (wu *WorkspaceUpdate) SetName(s string) *WorkspaceUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (wu *WorkspaceUpdate) SetLockedAt(t time.Time) *WorkspaceUpdate {
+	wu.mutation.SetLockedAt(t)
+	return wu
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (wu *WorkspaceUpdate) SetNillableLockedAt(t *time.Time) *WorkspaceUpdate {
+	if t != nil {
+		wu.SetLockedAt(*t)
+	}
+	return wu
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (wu *WorkspaceUpdate) ClearLockedAt() *WorkspaceUpdate {
+	wu.mutation.ClearLockedAt()
+	return wu
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (wu *WorkspaceUpdate) AddMembershipIDs(ids ...string) *WorkspaceUpdate {
+	wu.mutation.AddMembershipIDs(ids...)
+	return wu
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (wu *WorkspaceUpdate) AddMemberships(m ...*Membership) *WorkspaceUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return wu.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (wu *WorkspaceUpdate) AddInvitationIDs(ids ...string) *WorkspaceUpdate {
+	wu.mutation.AddInvitationIDs(ids...)
+	return wu
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (wu *WorkspaceUpdate) AddInvitations(i ...*Invitation) *WorkspaceUpdate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wu.AddInvitationIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (wu *WorkspaceUpdate) AddGroupIDs(ids ...string) *WorkspaceUpdate {
+	wu.mutation.AddGroupIDs(ids...)
+	return wu
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (wu *WorkspaceUpdate) AddGroups(g ...*Group) *WorkspaceUpdate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return wu.AddGroupIDs(ids...)
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (wu *WorkspaceUpdate) Mutation() *WorkspaceMutation {
+	return wu.mutation
+}
+
+// ClearMemberships clears all "memberships" edges to the Membership entity.
+func (wu *WorkspaceUpdate) ClearMemberships() *WorkspaceUpdate {
+	wu.mutation.ClearMemberships()
+	return wu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to Membership entities by IDs.
+func (wu *WorkspaceUpdate) RemoveMembershipIDs(ids ...string) *WorkspaceUpdate {
+	wu.mutation.RemoveMembershipIDs(ids...)
+	return wu
+}
+
+// RemoveMemberships removes "memberships" edges to Membership entities.
+func (wu *WorkspaceUpdate) RemoveMemberships(m ...*Membership) *WorkspaceUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return wu.RemoveMembershipIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (wu *WorkspaceUpdate) ClearInvitations() *WorkspaceUpdate {
+	wu.mutation.ClearInvitations()
+	return wu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (wu *WorkspaceUpdate) RemoveInvitationIDs(ids ...string) *WorkspaceUpdate {
+	wu.mutation.RemoveInvitationIDs(ids...)
+	return wu
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (wu *WorkspaceUpdate) RemoveInvitations(i ...*Invitation) *WorkspaceUpdate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wu.RemoveInvitationIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (wu *WorkspaceUpdate) ClearGroups() *WorkspaceUpdate {
+	wu.mutation.ClearGroups()
+	return wu
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (wu *WorkspaceUpdate) RemoveGroupIDs(ids ...string) *WorkspaceUpdate {
+	wu.mutation.RemoveGroupIDs(ids...)
+	return wu
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (wu *WorkspaceUpdate) RemoveGroups(g ...*Group) *WorkspaceUpdate {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return wu.RemoveGroupIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WorkspaceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wu.hooks) == 0 {
+		affected, err = wu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WorkspaceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wu.mutation = mutation
+			affected, err = wu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wu.hooks) - 1; i >= 0; i-- {
+			mut = wu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WorkspaceUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WorkspaceUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WorkspaceUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wu *WorkspaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   workspace.Table,
+			Columns: workspace.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: workspace.FieldID,
+			},
+		},
+	}
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workspace.FieldName,
+		})
+	}
+	if value, ok := wu.mutation.LockedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: workspace.FieldLockedAt,
+		})
+	}
+	if wu.mutation.LockedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: workspace.FieldLockedAt,
+		})
+	}
+	if wu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !wu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !wu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !wu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspace.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WorkspaceUpdateOne is the builder for updating a single Workspace entity.
+type WorkspaceUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WorkspaceMutation
+}
+
+// SetName sets the "name" field.
+func (wuo *WorkspaceUpdateOne) SetName(s string) *WorkspaceUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (wuo *WorkspaceUpdateOne) SetLockedAt(t time.Time) *WorkspaceUpdateOne {
+	wuo.mutation.SetLockedAt(t)
+	return wuo
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (wuo *WorkspaceUpdateOne) SetNillableLockedAt(t *time.Time) *WorkspaceUpdateOne {
+	if t != nil {
+		wuo.SetLockedAt(*t)
+	}
+	return wuo
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (wuo *WorkspaceUpdateOne) ClearLockedAt() *WorkspaceUpdateOne {
+	wuo.mutation.ClearLockedAt()
+	return wuo
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by IDs.
+func (wuo *WorkspaceUpdateOne) AddMembershipIDs(ids ...string) *WorkspaceUpdateOne {
+	wuo.mutation.AddMembershipIDs(ids...)
+	return wuo
+}
+
+// AddMemberships adds the "memberships" edges to the Membership entity.
+func (wuo *WorkspaceUpdateOne) AddMemberships(m ...*Membership) *WorkspaceUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return wuo.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (wuo *WorkspaceUpdateOne) AddInvitationIDs(ids ...string) *WorkspaceUpdateOne {
+	wuo.mutation.AddInvitationIDs(ids...)
+	return wuo
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (wuo *WorkspaceUpdateOne) AddInvitations(i ...*Invitation) *WorkspaceUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wuo.AddInvitationIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (wuo *WorkspaceUpdateOne) AddGroupIDs(ids ...string) *WorkspaceUpdateOne {
+	wuo.mutation.AddGroupIDs(ids...)
+	return wuo
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (wuo *WorkspaceUpdateOne) AddGroups(g ...*Group) *WorkspaceUpdateOne {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return wuo.AddGroupIDs(ids...)
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (wuo *WorkspaceUpdateOne) Mutation() *WorkspaceMutation {
+	return wuo.mutation
+}
+
+// ClearMemberships clears all "memberships" edges to the Membership entity.
+func (wuo *WorkspaceUpdateOne) ClearMemberships() *WorkspaceUpdateOne {
+	wuo.mutation.ClearMemberships()
+	return wuo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to Membership entities by IDs.
+func (wuo *WorkspaceUpdateOne) RemoveMembershipIDs(ids ...string) *WorkspaceUpdateOne {
+	wuo.mutation.RemoveMembershipIDs(ids...)
+	return wuo
+}
+
+// RemoveMemberships removes "memberships" edges to Membership entities.
+func (wuo *WorkspaceUpdateOne) RemoveMemberships(m ...*Membership) *WorkspaceUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return wuo.RemoveMembershipIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (wuo *WorkspaceUpdateOne) ClearInvitations() *WorkspaceUpdateOne {
+	wuo.mutation.ClearInvitations()
+	return wuo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (wuo *WorkspaceUpdateOne) RemoveInvitationIDs(ids ...string) *WorkspaceUpdateOne {
+	wuo.mutation.RemoveInvitationIDs(ids...)
+	return wuo
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (wuo *WorkspaceUpdateOne) RemoveInvitations(i ...*Invitation) *WorkspaceUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wuo.RemoveInvitationIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (wuo *WorkspaceUpdateOne) ClearGroups() *WorkspaceUpdateOne {
+	wuo.mutation.ClearGroups()
+	return wuo
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (wuo *WorkspaceUpdateOne) RemoveGroupIDs(ids ...string) *WorkspaceUpdateOne {
+	wuo.mutation.RemoveGroupIDs(ids...)
+	return wuo
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (wuo *WorkspaceUpdateOne) RemoveGroups(g ...*Group) *WorkspaceUpdateOne {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return wuo.RemoveGroupIDs(ids...)
+}
+
+// Save executes the query and returns the updated Workspace entity.
+func (wuo *WorkspaceUpdateOne) Save(ctx context.Context) (*Workspace, error) {
+	var (
+		err  error
+		node *Workspace
+	)
+	if len(wuo.hooks) == 0 {
+		node, err = wuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WorkspaceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wuo.mutation = mutation
+			node, err = wuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wuo.hooks) - 1; i >= 0; i-- {
+			mut = wuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WorkspaceUpdateOne) SaveX(ctx context.Context) *Workspace {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WorkspaceUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WorkspaceUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wuo *WorkspaceUpdateOne) sqlSave(ctx context.Context) (_node *Workspace, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   workspace.Table,
+			Columns: workspace.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: workspace.FieldID,
+			},
+		},
+	}
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Workspace.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workspace.FieldName,
+		})
+	}
+	if value, ok := wuo.mutation.LockedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: workspace.FieldLockedAt,
+		})
+	}
+	if wuo.mutation.LockedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: workspace.FieldLockedAt,
+		})
+	}
+	if wuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !wuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: membership.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !wuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: invitation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !wuo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.GroupsTable,
+			Columns: []string{workspace.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Workspace{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspace.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,360 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+const (
+	maxGroupNameLength = 100
+	maxGroupMembers    = 500
+)
+
+// Payloads of the group api used by the groups component. Members are referenced by their email.
+
+type createGroupRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions,omitempty"`
+	Members     []string `json:"members,omitempty"`
+}
+
+func (req *createGroupRequest) Bind(r *http.Request) error {
+	v := new(validator)
+	v.required("name", req.Name)
+	v.maxLength("name", req.Name, maxGroupNameLength)
+	validateGroupFields(v, req.Permissions, req.Members)
+	return v.err()
+}
+
+// patchGroupRequest replaces the given fields, an empty list removes all the permissions or members.
+type patchGroupRequest struct {
+	Name        *string  `json:"name,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Members     []string `json:"members,omitempty"`
+}
+
+func (req *patchGroupRequest) Bind(r *http.Request) error {
+	v := new(validator)
+	v.check(req.Name != nil || req.Permissions != nil || req.Members != nil, "name", "or another field is required")
+	if req.Name != nil {
+		v.required("name", *req.Name)
+		v.maxLength("name", *req.Name, maxGroupNameLength)
+	}
+	validateGroupFields(v, req.Permissions, req.Members)
+	return v.err()
+}
+
+func validateGroupFields(v *validator, permissions, members []string) {
+	for _, permission := range permissions {
+		v.check(isPermission(permission), "permissions",
+			fmt.Sprintf("must be one of %s", strings.Join(allPermissions, ", ")))
+	}
+	v.check(len(members) <= maxGroupMembers, "members", fmt.Sprintf("must have at most %d items", maxGroupMembers))
+}
+
+func isPermission(permission string) bool {
+	for _, p := range allPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type groupResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Permissions []string  `json:"permissions"`
+	Members     []string  `json:"members"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// newGroupResponse needs the members of the group to be loaded, see findGroup.
+func newGroupResponse(g *models.Group) *groupResponse {
+	members := make([]string, 0, len(g.Edges.Members))
+	for _, m := range g.Edges.Members {
+		members = append(members, m.Email)
+	}
+	sort.Strings(members)
+	permissions := g.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return &groupResponse{
+		ID:          g.ID,
+		Name:        g.Name,
+		Permissions: permissions,
+		Members:     members,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+}
+
+func (res *groupResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+type groupListResponse struct {
+	Groups []*groupResponse `json:"groups"`
+}
+
+func (res *groupListResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+type deleteGroupResponse struct {
+	Success bool `json:"success"`
+}
+
+func (res *deleteGroupResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func findGroup(ctx context.Context, appCtx Context, workspaceID, id string) (*models.Group, error) {
+	return appCtx.db.Group.Query().
+		Where(group.ID(id), group.HasWorkspaceWith(workspace.ID(workspaceID))).
+		WithMembers().
+		Only(ctx)
+}
+
+// groupMemberIDs returns the ids of the memberships of the workspace with the emails.
+func groupMemberIDs(ctx context.Context, appCtx Context, workspaceID string, emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return nil, nil
+	}
+	for i := range emails {
+		emails[i] = strings.ToLower(strings.TrimSpace(emails[i]))
+	}
+	memberships, err := appCtx.db.Membership.Query().
+		Where(membership.EmailIn(emails...), membership.HasWorkspaceWith(workspace.ID(workspaceID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(memberships))
+	found := make(map[string]bool)
+	for _, m := range memberships {
+		ids = append(ids, m.ID)
+		found[strings.ToLower(m.Email)] = true
+	}
+	for _, email := range emails {
+		if !found[email] {
+			return nil, fieldErrors{"members": fmt.Sprintf("%s is not a member of the workspace", email)}
+		}
+	}
+	return ids, nil
+}
+
+// checkGrantable returns an error for the first permission which the account doesn't hold itself in the workspace, a
+// group can't be used to escalate its members beyond the permissions of the account which manages it.
+func checkGrantable(r *http.Request, appCtx Context, permissions []string) error {
+	for _, permission := range permissions {
+		ok, err := hasPermission(r.Context(), appCtx, authn.AccountIDFromContext(r), workspaceIDFromContext(r), permission)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errGrantForbidden(permission)
+		}
+	}
+	return nil
+}
+
+type errGrantForbidden string
+
+func (e errGrantForbidden) Error() string {
+	return fmt.Sprintf("the %s permission can't be granted without holding it", string(e))
+}
+
+func renderGroupErr(w http.ResponseWriter, r *http.Request, err error) {
+	if models.IsNotFound(err) {
+		render.Render(w, r, ErrNotFound)
+		return
+	}
+	if models.IsConstraintError(err) {
+		render.Render(w, r, ErrValidation(fieldErrors{"name": "is used by another group"}))
+		return
+	}
+	if _, ok := err.(fieldErrors); ok {
+		render.Render(w, r, ErrValidation(err))
+		return
+	}
+	if _, ok := err.(errGrantForbidden); ok {
+		render.Render(w, r, ErrForbidden(err))
+		return
+	}
+	render.Render(w, r, ErrInternal(err))
+}
+
+func listGroups(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groups, err := t.db.Group.Query().
+			Where(group.HasWorkspaceWith(workspace.ID(workspaceIDFromContext(r)))).
+			WithMembers().
+			Order(models.Asc(group.FieldName)).
+			All(r.Context())
+		if err != nil {
+			render.Render(w, r, ErrInternal(err))
+			return
+		}
+
+		res := &groupListResponse{Groups: make([]*groupResponse, 0, len(groups))}
+		for _, g := range groups {
+			res.Groups = append(res.Groups, newGroupResponse(g))
+		}
+		render.Render(w, r, res)
+	}
+}
+
+func getGroup(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		g, err := findGroup(r.Context(), t, workspaceIDFromContext(r), chi.URLParam(r, "id"))
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+		render.Render(w, r, newGroupResponse(g))
+	}
+}
+
+func createGroup(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(createGroupRequest)
+		err := render.Bind(r, req)
+		if err != nil {
+			renderBindErr(w, r, err)
+			return
+		}
+
+		if err := checkGrantable(r, t, req.Permissions); err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+
+		workspaceID := workspaceIDFromContext(r)
+		memberIDs, err := groupMemberIDs(r.Context(), t, workspaceID, req.Members)
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+
+		created, err := t.db.Group.Create().
+			SetID(shortuuid.New()).
+			SetName(strings.TrimSpace(req.Name)).
+			SetPermissions(req.Permissions).
+			SetWorkspaceID(workspaceID).
+			AddMemberIDs(memberIDs...).
+			Save(r.Context())
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+
+		g, err := findGroup(r.Context(), t, workspaceID, created.ID)
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+		render.Render(w, r, newGroupResponse(g))
+	}
+}
+
+func patchGroup(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(patchGroupRequest)
+		err := render.Bind(r, req)
+		if err != nil {
+			renderBindErr(w, r, err)
+			return
+		}
+
+		workspaceID := workspaceIDFromContext(r)
+		g, err := findGroup(r.Context(), t, workspaceID, chi.URLParam(r, "id"))
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+
+		update := g.Update()
+		if req.Name != nil {
+			update.SetName(strings.TrimSpace(*req.Name))
+		}
+		// the permissions which the group already has can be kept or removed by anyone who manages the groups, but
+		// changing the members grants all of them to the new members
+		permissions, granted := g.Permissions, []string(nil)
+		if req.Permissions != nil {
+			held := make(map[string]bool)
+			for _, permission := range g.Permissions {
+				held[permission] = true
+			}
+			for _, permission := range req.Permissions {
+				if !held[permission] {
+					granted = append(granted, permission)
+				}
+			}
+			permissions = req.Permissions
+			update.SetPermissions(req.Permissions)
+		}
+		if req.Members != nil {
+			granted = permissions
+		}
+		if err := checkGrantable(r, t, granted); err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+		if req.Members != nil {
+			memberIDs, err := groupMemberIDs(r.Context(), t, workspaceID, req.Members)
+			if err != nil {
+				renderGroupErr(w, r, err)
+				return
+			}
+			update.ClearMembers().AddMemberIDs(memberIDs...)
+		}
+		_, err = update.Save(r.Context())
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+
+		g, err = findGroup(r.Context(), t, workspaceID, g.ID)
+		if err != nil {
+			renderGroupErr(w, r, err)
+			return
+		}
+		render.Render(w, r, newGroupResponse(g))
+	}
+}
+
+// deleteGroup removes the group, its tasks are left unassigned.
+func deleteGroup(t Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deleted, err := t.db.Group.Delete().
+			Where(group.ID(chi.URLParam(r, "id")), group.HasWorkspaceWith(workspace.ID(workspaceIDFromContext(r)))).
+			Exec(r.Context())
+		if err != nil {
+			render.Render(w, r, ErrInternal(err))
+			return
+		}
+		if deleted == 0 {
+			render.Render(w, r, ErrNotFound)
+			return
+		}
+		render.Render(w, r, &deleteGroupResponse{Success: true})
+	}
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+)
+
+func TestGroupPermissionsAreLimitedToTheCaller(t *testing.T) {
+	appCtx := newTestContext(t)
+	roles, err := newRolePermissions([]Role{
+		{Name: string(membership.RoleOwner), Permissions: allPermissions},
+		{Name: string(membership.RoleMember), Permissions: []string{permTasksRead, permGroupsManage}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appCtx.roles = roles
+	api := newTestAPI(t, appCtx)
+	ownerID, ownerToken := newTestAccount(t, appCtx, "owner@example.com")
+	memberID, memberToken := newTestAccount(t, appCtx, "member@example.com")
+
+	personal, err := ensurePersonalWorkspace(context.Background(), appCtx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = appCtx.db.Membership.Create().
+		SetID(shortuuid.New()).
+		SetAccountID(memberID).
+		SetEmail("member@example.com").
+		SetRole(membership.RoleMember).
+		SetWorkspace(personal).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := func(token, method, path string, body interface{}, res interface{}) int {
+		req := newAPIRequest(t, token, method, path, body)
+		req.Header.Set(workspaceHeader, personal.ID)
+		w := serve(api, req)
+		if res != nil && w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code
+	}
+
+	if code := request(memberToken, http.MethodPost, "/groups", createGroupRequest{Name: "admins", Permissions: []string{permWebhooksManage}}, nil); code != http.StatusForbidden {
+		t.Fatalf("granting a permission the member doesn't hold: got %d, want %d", code, http.StatusForbidden)
+	}
+	created := new(groupResponse)
+	if code := request(memberToken, http.MethodPost, "/groups", createGroupRequest{Name: "readers", Permissions: []string{permTasksRead}}, created); code != http.StatusOK {
+		t.Fatalf("create: got %d", code)
+	}
+
+	path := "/groups/" + created.ID
+	if code := request(ownerToken, http.MethodPatch, path, map[string][]string{"permissions": {permTasksRead, permWebhooksManage}}, nil); code != http.StatusOK {
+		t.Fatalf("patch by the owner: got %d", code)
+	}
+	// the permissions which the group already has can be kept
+	if code := request(memberToken, http.MethodPatch, path, map[string][]string{"permissions": {permWebhooksManage}}, nil); code != http.StatusOK {
+		t.Fatalf("patch keeping a permission: got %d", code)
+	}
+	if code := request(memberToken, http.MethodPatch, path, map[string][]string{"permissions": {permWebhooksManage, permMembersManage}}, nil); code != http.StatusForbidden {
+		t.Fatalf("patch adding a permission the member doesn't hold: got %d, want %d", code, http.StatusForbidden)
+	}
+	// joining the group would grant its permissions which the member doesn't hold
+	if code := request(memberToken, http.MethodPatch, path, map[string][]string{"members": {"member@example.com"}}, nil); code != http.StatusForbidden {
+		t.Fatalf("patch adding the member to the group: got %d, want %d", code, http.StatusForbidden)
+	}
+	if ok, err := hasPermission(context.Background(), appCtx, memberID, personal.ID, permWebhooksManage); err != nil || ok {
+		t.Fatalf("the member was granted %s: %v", permWebhooksManage, err)
+	}
+	if code := request(ownerToken, http.MethodPatch, path, map[string][]string{"members": {"member@example.com"}}, nil); code != http.StatusOK {
+		t.Fatalf("patch of the members by the owner: got %d", code)
+	}
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/idempotencykey"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	idempotencyKeyTTL    = 24 * time.Hour
+	// a key which is still in progress after this long was claimed by a request which never finished, like one of a
+	// crashed process, and can be claimed again
+	idempotencyKeyLease     = time.Minute
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotent stores the response to a request with an Idempotency-Key header per account and key for 24 hours.
+// A retry with the same key is answered with the stored response and headers, a retry with the same key but a
+// different request, including one in another workspace, gets a 422 and a retry while the first request is still in
+// progress gets a 409. Server errors are not stored so that the request can be retried.
+func idempotent(appCtx Context) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				render.Render(w, r, ErrInvalidRequest(
+					fmt.Errorf("%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)))
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				render.Render(w, r, ErrInvalidRequest(err))
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+			owner := authn.AccountIDFromContext(r)
+			requestHash := hashRequest(r, body)
+			stored, err := claimIdempotencyKey(r.Context(), appCtx, owner, key, requestHash)
+			if err != nil {
+				render.Render(w, r, ErrInternal(err))
+				return
+			}
+
+			if stored != nil {
+				switch {
+				case stored.RequestHash != requestHash:
+					render.Render(w, r, ErrIdempotencyKeyReused)
+				case stored.StatusCode == 0:
+					render.Render(w, r, ErrIdempotencyKeyInProgress)
+				default:
+					for name, values := range stored.Headers {
+						w.Header()[name] = values
+					}
+					w.Header().Set("Idempotent-Replayed", "true")
+					w.WriteHeader(stored.StatusCode)
+					_, _ = w.Write(stored.Response)
+				}
+				return
+			}
+
+			var response bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&response)
+			defer func() {
+				if p := recover(); p != nil {
+					// like after a server error, the key is released so that the request can be retried
+					if err := releaseIdempotencyKey(appCtx, owner, key); err != nil {
+						log.Printf("idempotent: releasing key %s: %v\n", key, err)
+					}
+					panic(p)
+				}
+			}()
+			next.ServeHTTP(ww, r)
+
+			// the request context might be done once the response is written
+			ctx := context.Background()
+			statusCode := ww.Status()
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			if statusCode >= http.StatusInternalServerError {
+				err = releaseIdempotencyKey(appCtx, owner, key)
+			} else {
+				_, err = appCtx.db.IdempotencyKey.Update().
+					Where(idempotencykey.Owner(owner), idempotencykey.Key(key)).
+					SetStatusCode(statusCode).
+					SetHeaders(replayedHeaders(ww.Header())).
+					SetResponse(response.Bytes()).
+					Save(ctx)
+			}
+			if err != nil {
+				log.Printf("idempotent: saving response of key %s: %v\n", key, err)
+			}
+		})
+	}
+}
+
+// claimIdempotencyKey stores the key for a new request. It returns the previously stored key if the key was used before.
+// A key whose request is in progress for longer than idempotencyKeyLease is claimed again.
+func claimIdempotencyKey(ctx context.Context, appCtx Context, owner, key, requestHash string) (*models.IdempotencyKey, error) {
+	_, err := appCtx.db.IdempotencyKey.Delete().Where(idempotencykey.ExpiresAtLT(time.Now())).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		_, err = appCtx.db.IdempotencyKey.Create().
+			SetOwner(owner).
+			SetKey(key).
+			SetRequestHash(requestHash).
+			SetExpiresAt(time.Now().Add(idempotencyKeyTTL)).
+			Save(ctx)
+		if err == nil {
+			return nil, nil
+		}
+		if !models.IsConstraintError(err) {
+			return nil, err
+		}
+
+		stored, err := appCtx.db.IdempotencyKey.Query().
+			Where(idempotencykey.Owner(owner), idempotencykey.Key(key)).
+			Only(ctx)
+		if err != nil || attempt > 0 || stored.StatusCode != 0 || time.Since(stored.CreatedAt) < idempotencyKeyLease {
+			return stored, err
+		}
+		// only one of the concurrent retries removes the abandoned claim, the others get the 409 of its new claim
+		abandoned, err := appCtx.db.IdempotencyKey.Delete().
+			Where(
+				idempotencykey.ID(stored.ID),
+				idempotencykey.StatusCodeIsNil(),
+				idempotencykey.CreatedAtLT(time.Now().Add(-idempotencyKeyLease)),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if abandoned == 0 {
+			return stored, nil
+		}
+	}
+}
+
+// replayedHeaders returns the response headers which are stored with the response, the headers which belong to the
+// original response only are left out.
+func replayedHeaders(header http.Header) http.Header {
+	replayed := header.Clone()
+	for _, name := range []string{"Set-Cookie", "Date", "Content-Length", "Idempotent-Replayed"} {
+		replayed.Del(name)
+	}
+	return replayed
+}
+
+// releaseIdempotencyKey deletes the key claimed by a request which failed, a retry with the key is handled as a new request.
+func releaseIdempotencyKey(appCtx Context, owner, key string) error {
+	// the request context might be done once the handler failed
+	_, err := appCtx.db.IdempotencyKey.Delete().Where(
+		idempotencykey.Owner(owner), idempotencykey.Key(key),
+	).Exec(context.Background())
+	return err
+}
+
+func hashRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	// a key reused in another workspace is a different request
+	fmt.Fprintf(h, "%s %s %s\n", workspaceIDFromContext(r), r.Method, r.URL.Path)
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/adnaan/authn"
+)
+
+func TestIdempotentReleasesKeyOnPanic(t *testing.T) {
+	appCtx := newTestContext(t)
+	panics := true
+	handler := idempotent(appCtx)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if panics {
+			panic("handler failed")
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	request := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"text":"retried"}`))
+		req.Header.Set(idempotencyKeyHeader, "key-1")
+		return serve(handler, req.WithContext(context.WithValue(req.Context(), authn.AccountIDKey, "owner")))
+	}
+
+	func() {
+		defer func() {
+			if p := recover(); p == nil {
+				t.Fatal("the panic was swallowed")
+			}
+		}()
+		request()
+	}()
+
+	// the retry isn't answered with a 409 as if the first request was still in progress
+	panics = false
+	if w := request(); w.Code != http.StatusCreated || w.Header().Get("Idempotent-Replayed") != "" {
+		t.Fatalf("got %d, want the retry to be handled", w.Code)
+	}
+	if w := request(); w.Code != http.StatusCreated || w.Header().Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("got %d, want the stored response", w.Code)
+	}
+}
+
+func TestIdempotentReplay(t *testing.T) {
+	appCtx := newTestContext(t)
+	handled := 0
+	handler := idempotent(appCtx)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handled++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"1"`)
+		w.Header().Set("Location", "/tasks/task-1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"task-1"}`))
+	}))
+	request := func(workspaceID, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"text":"replayed"}`))
+		req.Header.Set(idempotencyKeyHeader, key)
+		ctx := context.WithValue(req.Context(), authn.AccountIDKey, "owner")
+		ctx = context.WithValue(ctx, workspaceIDKey, workspaceID)
+		return serve(handler, req.WithContext(ctx))
+	}
+
+	request("workspace-1", "key-1")
+	w := request("workspace-1", "key-1")
+	if w.Code != http.StatusCreated || w.Header().Get("Idempotent-Replayed") != "true" || handled != 1 {
+		t.Fatalf("got %d after %d calls, want the stored response", w.Code, handled)
+	}
+	for name, want := range map[string]string{"Content-Type": "application/json", "ETag": `"1"`, "Location": "/tasks/task-1"} {
+		if got := w.Header().Get(name); got != want {
+			t.Errorf("replayed %s: got %q, want %q", name, got, want)
+		}
+	}
+
+	// the same key and body in another workspace isn't the same request
+	if w := request("workspace-2", "key-1"); w.Code != http.StatusUnprocessableEntity || handled != 1 {
+		t.Fatalf("got %d, want %d", w.Code, http.StatusUnprocessableEntity)
+	}
+
+	// a claim which is in progress for longer than the lease was abandoned
+	claim := func(key string, at time.Time) {
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"text":"replayed"}`))
+		req = req.WithContext(context.WithValue(req.Context(), workspaceIDKey, "workspace-1"))
+		_, err := appCtx.db.IdempotencyKey.Create().
+			SetOwner("owner").
+			SetKey(key).
+			SetRequestHash(hashRequest(req, []byte(`{"text":"replayed"}`))).
+			SetCreatedAt(at).
+			SetExpiresAt(at.Add(idempotencyKeyTTL)).
+			Save(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	claim("in-progress", time.Now())
+	if w := request("workspace-1", "in-progress"); w.Code != http.StatusConflict {
+		t.Fatalf("got %d, want %d", w.Code, http.StatusConflict)
+	}
+	claim("abandoned", time.Now().Add(-2*idempotencyKeyLease))
+	if w := request("workspace-1", "abandoned"); w.Code != http.StatusCreated || w.Header().Get("Idempotent-Replayed") != "" {
+		t.Fatalf("got %d, want the request to be handled", w.Code)
+	}
+}
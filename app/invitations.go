@@ -0,0 +1,435 @@
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/lithammer/shortuuid/v3"
+
+	rl "github.com/adnaan/renderlayout"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+const maxInvitationsPerRequest = 20
+
+// same as the pattern of the email inputs of the account forms
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+var errInvitationInvalid = fmt.Errorf("the invitation is invalid or has expired")
+
+// invitationView is a pending invitation listed on the members tab.
+type invitationView struct {
+	ID        string          `json:"id"`
+	Email     string          `json:"email"`
+	Role      invitation.Role `json:"role"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Expired   bool            `json:"expired"`
+}
+
+// invitationToken returns the token of the invitation link. It's only valid until the nonce is rotated.
+func invitationToken(appCtx Context, inv *models.Invitation) (string, error) {
+	return appCtx.branca.EncodeToString(inv.ID + ":" + inv.Nonce)
+}
+
+// invitationFromToken returns the pending invitation of the token along with its workspace.
+func invitationFromToken(ctx context.Context, appCtx Context, token string) (*models.Invitation, error) {
+	decoded, err := appCtx.branca.DecodeToString(token)
+	if err != nil {
+		return nil, errInvitationInvalid
+	}
+	parts := strings.SplitN(decoded, ":", 2)
+	if len(parts) != 2 {
+		return nil, errInvitationInvalid
+	}
+
+	inv, err := appCtx.db.Invitation.Query().
+		Where(invitation.ID(parts[0])).
+		WithWorkspace().
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, errInvitationInvalid
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(inv.Nonce), []byte(parts[1])) != 1 ||
+		inv.Status != invitation.StatusPending || inv.ExpiresAt.Before(time.Now()) {
+		return nil, errInvitationInvalid
+	}
+	return inv, nil
+}
+
+func invitationExpiry(appCtx Context) time.Time {
+	return time.Now().Add(time.Duration(appCtx.cfg.InvitationTTLHours) * time.Hour)
+}
+
+// sendInvitation emails the invitation link to the invitee.
+func sendInvitation(ctx context.Context, appCtx Context, inv *models.Invitation, workspaceName string) error {
+	token, err := invitationToken(appCtx, inv)
+	if err != nil {
+		return err
+	}
+	inviter, err := accountEmail(ctx, appCtx, inv.InvitedBy)
+	if err != nil {
+		return err
+	}
+
+	appName := appCtx.mailer.appName
+	subject := fmt.Sprintf("You have been invited to %s on %s", workspaceName, appName)
+	link := fmt.Sprintf("%s/invite/%s", appCtx.cfg.Domain, token)
+	return appCtx.mailer.send(inv.Email, subject, invite(appName, workspaceName, inviter, link))
+}
+
+// inviteMember creates a pending invitation of the email to the workspace. Pending invitations previously sent to the
+// email are revoked so that only the latest link is valid.
+func inviteMember(ctx context.Context, appCtx Context, ws *models.Workspace, inviterID, email string, role invitation.Role) (*models.Invitation, error) {
+	isMember, err := appCtx.db.Membership.Query().Where(
+		membership.Email(email), membership.HasWorkspaceWith(workspace.ID(ws.ID)),
+	).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, fmt.Errorf("%s is already a member of the workspace", email)
+	}
+
+	_, err = appCtx.db.Invitation.Update().
+		Where(
+			invitation.Email(email),
+			invitation.HasWorkspaceWith(workspace.ID(ws.ID)),
+			invitation.StatusEQ(invitation.StatusPending),
+		).
+		SetStatus(invitation.StatusRevoked).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return appCtx.db.Invitation.Create().
+		SetID(shortuuid.New()).
+		SetEmail(email).
+		SetRole(role).
+		SetNonce(uuid.New().String()).
+		SetInvitedBy(inviterID).
+		SetExpiresAt(invitationExpiry(appCtx)).
+		SetWorkspace(ws).
+		Save(ctx)
+}
+
+// acceptInvitation adds the account to the workspace of the invitation with the invited role.
+func acceptInvitation(ctx context.Context, appCtx Context, inv *models.Invitation, accountID, email string) error {
+	tx, err := appCtx.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	isMember, err := tx.Membership.Query().Where(
+		membership.AccountID(accountID), membership.HasWorkspaceWith(workspace.ID(inv.Edges.Workspace.ID)),
+	).Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if !isMember {
+		_, err = tx.Membership.Create().
+			SetID(shortuuid.New()).
+			SetAccountID(accountID).
+			SetEmail(email).
+			SetRole(membership.Role(inv.Role)).
+			SetWorkspaceID(inv.Edges.Workspace.ID).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Invitation.UpdateOneID(inv.ID).
+		SetStatus(invitation.StatusAccepted).
+		SetRespondedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// pendingInvitations returns the pending invitations of the workspace for the members component.
+func pendingInvitations(ctx context.Context, appCtx Context, workspaceID string) ([]invitationView, error) {
+	invitations, err := appCtx.db.Invitation.Query().
+		Where(
+			invitation.HasWorkspaceWith(workspace.ID(workspaceID)),
+			invitation.StatusEQ(invitation.StatusPending),
+		).
+		Order(models.Asc(invitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]invitationView, 0, len(invitations))
+	for _, inv := range invitations {
+		views = append(views, invitationView{
+			ID:        inv.ID,
+			Email:     inv.Email,
+			Role:      inv.Role,
+			ExpiresAt: inv.ExpiresAt,
+			Expired:   inv.ExpiresAt.Before(time.Now()),
+		})
+	}
+	return views, nil
+}
+
+// managedInvitation returns the pending invitation of the current workspace if the account can manage its members.
+func managedInvitation(r *http.Request, appCtx Context) (*models.Invitation, error) {
+	if err := checkPermission(r, appCtx, permMembersManage); err != nil {
+		return nil, err
+	}
+
+	workspaceID := workspaceIDFromContext(r)
+	inv, err := appCtx.db.Invitation.Query().
+		Where(
+			invitation.ID(chi.URLParam(r, "id")),
+			invitation.HasWorkspaceWith(workspace.ID(workspaceID)),
+			invitation.StatusEQ(invitation.StatusPending),
+		).
+		WithWorkspace().
+		Only(r.Context())
+	if err != nil {
+		if models.IsNotFound(err) {
+			return nil, fmt.Errorf("invitation not found")
+		}
+		return nil, err
+	}
+	return inv, nil
+}
+
+func createInvitationsPage(appCtx Context) rl.Data {
+	type req struct {
+		// comma separated
+		Emails string
+		Role   string
+	}
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		form := new(req)
+		err := r.ParseForm()
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		err = appCtx.formDecoder.Decode(form, r.Form)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		var emails []string
+		for _, email := range strings.Split(form.Emails, ",") {
+			email = strings.ToLower(strings.TrimSpace(email))
+			if email != "" {
+				emails = append(emails, email)
+			}
+		}
+
+		v := new(validator)
+		v.check(len(emails) > 0, "emails", "is required")
+		v.check(len(emails) <= maxInvitationsPerRequest, "emails",
+			fmt.Sprintf("must have at most %d items", maxInvitationsPerRequest))
+		for _, email := range emails {
+			v.check(emailPattern.MatchString(email), "emails", fmt.Sprintf("%s is not a valid email", email))
+		}
+		v.check(invitation.RoleValidator(invitation.Role(form.Role)) == nil, "role",
+			fmt.Sprintf("must be one of %s, %s, %s", invitation.RoleAdmin, invitation.RoleMember, invitation.RoleGuest))
+		if err := v.err(); err != nil {
+			return formErrors(err)
+		}
+
+		if err := checkPermission(r, appCtx, permMembersManage); err != nil {
+			return nil, err
+		}
+
+		accountID := authn.AccountIDFromContext(r)
+		workspaceID := workspaceIDFromContext(r)
+		ws, err := appCtx.db.Workspace.Get(r.Context(), workspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		for _, email := range emails {
+			inv, err := inviteMember(r.Context(), appCtx, ws, accountID, email, invitation.Role(form.Role))
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+			// the invitation stays pending and can be resent if the email fails
+			err = sendInvitation(r.Context(), appCtx, inv, ws.Name)
+			if err != nil {
+				return nil, fmt.Errorf("%w", fmt.Errorf("sending the invitation to %s: %v", email, err))
+			}
+		}
+
+		http.Redirect(w, r, "/account#members", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
+
+func revokeInvitationPage(appCtx Context) rl.Data {
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		inv, err := managedInvitation(r, appCtx)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		err = inv.Update().SetStatus(invitation.StatusRevoked).Exec(r.Context())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		http.Redirect(w, r, "/account#members", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
+
+// resendInvitationPage emails a new invitation link and extends the expiry. The previous link stops working.
+func resendInvitationPage(appCtx Context) rl.Data {
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		inv, err := managedInvitation(r, appCtx)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		updated, err := inv.Update().
+			SetNonce(uuid.New().String()).
+			SetExpiresAt(invitationExpiry(appCtx)).
+			Save(r.Context())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		err = sendInvitation(r.Context(), appCtx, updated, inv.Edges.Workspace.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w", fmt.Errorf("sending the invitation to %s: %v", inv.Email, err))
+		}
+
+		http.Redirect(w, r, "/account#members", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
+
+// invitationPage shows the invitation to the invitee. Visitors who aren't logged in can log in or sign up and come
+// back here afterwards. They get the same choice whether or not the invited email has an account, so the page
+// doesn't tell who is signed up.
+func invitationPage(appCtx Context) rl.Data {
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		token := chi.URLParam(r, "token")
+		inv, err := invitationFromToken(r.Context(), appCtx, token)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		inviter, err := accountEmail(r.Context(), appCtx, inv.InvitedBy)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		data := rl.D{
+			"invitation":     inv,
+			"workspace_name": inv.Edges.Workspace.Name,
+			"inviter":        inviter,
+			"invite_token":   token,
+		}
+
+		account, err := appCtx.authn.CurrentAccount(r)
+		if err != nil {
+			data["login_required"] = true
+			data["login_url"] = "/login?from=" + url.QueryEscape("/invite/"+token)
+			data["signup_url"] = "/signup?invite=" + url.QueryEscape(token)
+			return data, nil
+		}
+		data["email_mismatch"] = !strings.EqualFold(account.Email(), inv.Email)
+		return data, nil
+	}
+}
+
+// respondToInvitationPage accepts or declines the invitation for the invited account.
+func respondToInvitationPage(appCtx Context) rl.Data {
+	type req struct {
+		Action string
+	}
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		form := new(req)
+		err := r.ParseForm()
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		err = appCtx.formDecoder.Decode(form, r.Form)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		inv, err := invitationFromToken(r.Context(), appCtx, chi.URLParam(r, "token"))
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		account, err := appCtx.authn.CurrentAccount(r)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(account.Email(), inv.Email) {
+			return nil, fmt.Errorf("%w", fmt.Errorf("the invitation was sent to %s", inv.Email))
+		}
+
+		switch form.Action {
+		case "accept":
+			err = acceptInvitation(r.Context(), appCtx, inv, account.ID().String(), account.Email())
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+			err = account.Attributes().Session().Set(w, workspaceSessionKey, inv.Edges.Workspace.ID)
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+		case "decline":
+			err = inv.Update().
+				SetStatus(invitation.StatusDeclined).
+				SetRespondedAt(time.Now()).
+				Exec(r.Context())
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+		default:
+			return nil, fmt.Errorf("%w", fmt.Errorf("action must be one of accept, decline"))
+		}
+
+		http.Redirect(w, r, "/app", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
+
+// invitedSignupPage prefills the signup form with the invited email when coming from an invitation link.
+func invitedSignupPage(appCtx Context) rl.Data {
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		token := r.URL.Query().Get("invite")
+		if token == "" {
+			return rl.D{}, nil
+		}
+		inv, err := invitationFromToken(r.Context(), appCtx, token)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return rl.D{
+			"invite_token": token,
+			"invite_email": inv.Email,
+		}, nil
+	}
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+)
+
+func TestRelativeRedirect(t *testing.T) {
+	for from, want := range map[string]string{
+		"/invite/abc":          "/invite/abc",
+		"/app?q=1":             "/app?q=1",
+		"":                     "",
+		"app":                  "",
+		"//evil.example":       "",
+		"/\\evil.example":      "",
+		"/\t/evil.example":     "",
+		"https://evil.example": "",
+		"javascript:alert(1)":  "",
+	} {
+		if got := relativeRedirect(from); got != want {
+			t.Errorf("%q: got %q, want %q", from, got, want)
+		}
+	}
+}
+
+func TestLoginRedirect(t *testing.T) {
+	appCtx := newTestContext(t)
+
+	// the from parameter of a magic link request is stored until the link is opened
+	w := httptest.NewRecorder()
+	rememberLoginFrom(w, httptest.NewRequest(http.MethodPost, "/login?from=%2Finvite%2Fabc", nil), appCtx)
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("got %d cookies, want 1", len(cookies))
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/magic-login/otp", nil)
+	req.AddCookie(cookies[0])
+	w = httptest.NewRecorder()
+	if got := loginRedirect(w, req); got != "/invite/abc" {
+		t.Fatalf("got %q, want the stored from", got)
+	}
+	if cleared := w.Result().Cookies(); len(cleared) != 1 || cleared[0].MaxAge >= 0 {
+		t.Fatal("the stored from isn't cleared")
+	}
+
+	// another site is never stored nor redirected to
+	w = httptest.NewRecorder()
+	rememberLoginFrom(w, httptest.NewRequest(http.MethodGet, "/auth?from=%2F%2Fevil.example", nil), appCtx)
+	if len(w.Result().Cookies()) != 0 {
+		t.Fatal("stored a from of another site")
+	}
+	req = httptest.NewRequest(http.MethodPost, "/login?from=https%3A%2F%2Fevil.example", nil)
+	if got := loginRedirect(httptest.NewRecorder(), req); got != "/app" {
+		t.Fatalf("got %q, want /app", got)
+	}
+}
+
+func TestInvitationPageDoesNotRevealAccounts(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.cfg.InvitationTTLHours = 1
+	ctx := context.Background()
+	ownerID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	newTestAccount(t, appCtx, "member@example.com")
+	ws, err := ensurePersonalWorkspace(ctx, appCtx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	visit := func(email string) map[string]interface{} {
+		inv, err := inviteMember(ctx, appCtx, ws, ownerID, email, invitation.RoleMember)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := invitationToken(appCtx, inv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// a visitor who isn't logged in
+		req := withURLParam(httptest.NewRequest(http.MethodGet, "/invite/"+token, nil), "token", token)
+		w := httptest.NewRecorder()
+		data, err := invitationPage(appCtx)(w, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d, want the page instead of a redirect", email, w.Code)
+		}
+		keys := map[string]interface{}{}
+		for k, v := range data {
+			keys[k] = v != nil
+		}
+		return keys
+	}
+
+	// the same page for an invitee with and without an account
+	withAccount, withoutAccount := visit("member@example.com"), visit("new@example.com")
+	if !reflect.DeepEqual(withAccount, withoutAccount) {
+		t.Fatalf("got %v with an account and %v without", withAccount, withoutAccount)
+	}
+	if withAccount["login_required"] != true {
+		t.Fatal("the visitor isn't asked to log in")
+	}
+}
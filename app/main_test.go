@@ -0,0 +1,163 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/chi"
+	"github.com/go-playground/form"
+	"github.com/hako/branca"
+	"github.com/lithammer/shortuuid/v3"
+
+	authnmodels "github.com/adnaan/authn/models"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/enttest"
+)
+
+const testAPIMasterSecret = "supersecretkeyyoushouldnotcommit"
+
+// newTestContext returns a Context backed by an in-memory sqlite database which is private to the test.
+func newTestContext(t *testing.T) Context {
+	t.Helper()
+	dataSource := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_"))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	db := enttest.Open(t, "sqlite3", dataSource)
+	t.Cleanup(func() { db.Close() })
+
+	accounts, err := authnmodels.Open("sqlite3", dataSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { accounts.Close() })
+
+	roles, err := newRolePermissions(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Config{Domain: "http://localhost:3000", APIMasterSecret: testAPIMasterSecret}
+	return Context{
+		db:          db,
+		accounts:    accounts,
+		cfg:         cfg,
+		formDecoder: form.NewDecoder(),
+		branca:      branca.NewBranca(testAPIMasterSecret),
+		prices:      newPriceCache(0),
+		webhooks:    map[string]WebhookSource{},
+		hub:         newTaskHub(ctx),
+		roles:       roles,
+		// private urls are refused like in production, withWebhookReceiver allows them for an httptest receiver
+		webhookClient: newWebhookClient(false),
+		authn: authn.New(ctx, authn.Config{
+			Driver:        "sqlite3",
+			Datasource:    dataSource,
+			SessionSecret: testAPIMasterSecret,
+		}),
+	}
+}
+
+// newTestAccount creates an account and returns its id and an api token.
+func newTestAccount(t *testing.T, appCtx Context, email string) (string, string) {
+	t.Helper()
+	apiKey := shortuuid.New()
+	acc, err := appCtx.accounts.Account.Create().
+		SetProvider("email").
+		SetEmail(email).
+		SetPassword("password1234").
+		SetConfirmed(true).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := setAPIKey(context.Background(), appCtx, acc.ID.String(), apiKey); err != nil {
+		t.Fatal(err)
+	}
+	token, err := appCtx.branca.EncodeToString(apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return acc.ID.String(), token
+}
+
+// newTestWorkspaceRequest returns a request of the account in its personal workspace as it's seen by the handlers
+// after the authentication and workspace middlewares.
+func newTestWorkspaceRequest(t *testing.T, appCtx Context, accountID, method, path string, body io.Reader) *http.Request {
+	t.Helper()
+	personal, err := ensurePersonalWorkspace(context.Background(), appCtx, accountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(method, path, body)
+	ctx := context.WithValue(req.Context(), authn.AccountIDKey, accountID)
+	ctx = context.WithValue(ctx, workspaceIDKey, personal.ID)
+	return req.WithContext(ctx)
+}
+
+// newTestAPI returns the current api version as it's mounted by Router.
+func newTestAPI(t *testing.T, appCtx Context) http.Handler {
+	t.Helper()
+	for _, version := range apiVersions {
+		if version.name != currentAPIVersion {
+			continue
+		}
+		handler, err := apiVersionRouter(appCtx, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return handler
+	}
+	t.Fatalf("api version %s not found", currentAPIVersion)
+	return nil
+}
+
+// newAPIRequest returns a json request authenticated with the token.
+func newAPIRequest(t *testing.T, token, method, path string, body interface{}) *http.Request {
+	t.Helper()
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// withURLParam sets the chi url param of a request which is passed to a handler without the router.
+func withURLParam(req *http.Request, key, value string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func serve(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+// apiRequest calls the api with the token and decodes the json response into res if it's not nil.
+func apiRequest(t *testing.T, handler http.Handler, token, method, path string, body, res interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	w := serve(handler, newAPIRequest(t, token, method, path, body))
+	if res != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+			t.Fatalf("%s %s: decoding %q: %v", method, path, w.Body.String(), err)
+		}
+	}
+	return w
+}
@@ -0,0 +1,375 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+const openAPIVersion = "3.0.3"
+
+// apiOperation documents a route of an api version. Request and Response are zero values of the payload types.
+type apiOperation struct {
+	Summary string
+	Query   []apiParam
+	// Idempotent operations accept an Idempotency-Key header
+	Idempotent bool
+	// Conditional operations accept an If-Match header with the ETag of the task
+	Conditional bool
+	// EventStream operations respond with server sent events carrying the Response as data
+	EventStream bool
+	Request     interface{}
+	Response    interface{}
+}
+
+type apiParam struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// v1Operations documents every route of the v1 api keyed by `METHOD /pattern`.
+// TestAPIOperations fails for a route which is missing here.
+var v1Operations = map[string]apiOperation{
+	"GET /tasks": {
+		Summary: "List tasks",
+		Query: []apiParam{
+			{Name: "status", Type: "string", Description: "comma separated list of statuses"},
+			{Name: "tag", Type: "string", Description: "comma separated list of tag names, matches tasks with any of them"},
+			{Name: "overdue", Type: "boolean", Description: "tasks past their due date which are not done"},
+			{Name: "group_id", Type: "string", Description: "tasks assigned to the group"},
+			{Name: "created_after", Type: "string", Description: "RFC3339 timestamp"},
+			{Name: "created_before", Type: "string", Description: "RFC3339 timestamp"},
+			{Name: "updated_after", Type: "string", Description: "RFC3339 timestamp"},
+			{Name: "updated_before", Type: "string", Description: "RFC3339 timestamp"},
+			{Name: "q", Type: "string", Description: "case insensitive text search"},
+			{Name: "sort", Type: "string", Description: "created_at, updated_at, text, status, due_at or position, prefix with - for descending order"},
+			{Name: "limit", Type: "integer", Description: fmt.Sprintf("page size, at most %d", maxPageSize)},
+			{Name: "cursor", Type: "string", Description: "next_cursor of the previous page, it must be used with the same sort"},
+		},
+		Response: taskListResponse{},
+	},
+	"POST /tasks": {
+		Summary:    "Create a task",
+		Idempotent: true,
+		Request:    createTaskRequest{},
+		Response:   taskResponse{},
+	},
+	"POST /tasks/batch": {
+		Summary:    "Create, update and delete tasks in a single transaction",
+		Idempotent: true,
+		Request:    batchTasksRequest{},
+		Response:   batchTasksResponse{},
+	},
+	"GET /tasks/events": {
+		Summary:     "Stream task changes",
+		EventStream: true,
+		Response:    taskEvent{},
+	},
+	"GET /tasks/{id}": {
+		Summary:  "Get a task",
+		Response: taskResponse{},
+	},
+	"PATCH /tasks/{id}": {
+		Summary:     "Update the given fields of a task",
+		Conditional: true,
+		Request:     patchTaskRequest{},
+		Response:    taskResponse{},
+	},
+	"PUT /tasks/{id}/status": {
+		Summary:     "Update the status of a task",
+		Conditional: true,
+		Request:     updateTaskStatusRequest{},
+		Response:    taskResponse{},
+	},
+	"PUT /tasks/{id}/text": {
+		Summary:     "Update the text of a task",
+		Conditional: true,
+		Request:     updateTaskTextRequest{},
+		Response:    taskResponse{},
+	},
+	"DELETE /tasks/{id}": {
+		Summary:     "Delete a task",
+		Conditional: true,
+		Response:    deleteTaskResponse{},
+	},
+	"GET /groups": {
+		Summary:  "List the groups of the workspace",
+		Response: groupListResponse{},
+	},
+	"POST /groups": {
+		Summary:  "Create a group",
+		Request:  createGroupRequest{},
+		Response: groupResponse{},
+	},
+	"GET /groups/{id}": {
+		Summary:  "Get a group",
+		Response: groupResponse{},
+	},
+	"PATCH /groups/{id}": {
+		Summary:  "Update the given fields of a group",
+		Request:  patchGroupRequest{},
+		Response: groupResponse{},
+	},
+	"DELETE /groups/{id}": {
+		Summary:  "Delete a group, its tasks are unassigned",
+		Response: deleteGroupResponse{},
+	},
+}
+
+// enum values of string types used in the payloads
+var apiEnums = map[reflect.Type][]string{
+	reflect.TypeOf(task.Status("")): {
+		string(task.StatusTodo), string(task.StatusInprogress), string(task.StatusDone),
+	},
+	reflect.TypeOf(task.Priority("")): {
+		string(task.PriorityLow), string(task.PriorityMedium), string(task.PriorityHigh),
+	},
+}
+
+var routeParamRegex = regexp.MustCompile(`{([^}:]+)(:[^}]*)?}`)
+
+type jsonObject map[string]interface{}
+
+// openAPISpec generates an OpenAPI 3 document for the routes of the api version mounted at basePath. The routes
+// without an apiOperation are left out of the document and returned sorted as "METHOD /route".
+func openAPISpec(cfg Config, basePath string, version apiVersion, routes chi.Routes) ([]byte, []string, error) {
+	schemas := make(jsonObject)
+	paths := make(jsonObject)
+	var undocumented []string
+
+	err := chi.Walk(routes, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		route = strings.TrimSuffix(route, "/")
+		if route == "" {
+			route = "/"
+		}
+		route = routeParamRegex.ReplaceAllString(route, "{$1}")
+
+		op, ok := version.operations[method+" "+route]
+		if !ok {
+			undocumented = append(undocumented, method+" "+route)
+			return nil
+		}
+
+		var params []jsonObject
+		for _, match := range routeParamRegex.FindAllStringSubmatch(route, -1) {
+			params = append(params, jsonObject{
+				"name":     match[1],
+				"in":       "path",
+				"required": true,
+				"schema":   jsonObject{"type": "string"},
+			})
+		}
+		for _, param := range op.Query {
+			params = append(params, jsonObject{
+				"name":        param.Name,
+				"in":          "query",
+				"description": param.Description,
+				"schema":      jsonObject{"type": param.Type},
+			})
+		}
+
+		operation := jsonObject{
+			"summary": op.Summary,
+			"responses": jsonObject{
+				"200":     jsonResponse("OK", op.EventStream, op.Response, schemas),
+				"default": jsonResponse("Error", false, ErrResponse{}, schemas),
+			},
+		}
+		params = append(params, jsonObject{
+			"name":        workspaceHeader,
+			"in":          "header",
+			"description": "id of the workspace, defaults to the personal workspace of the account",
+			"schema":      jsonObject{"type": "string"},
+		})
+		if op.Idempotent {
+			params = append(params, jsonObject{
+				"name":        idempotencyKeyHeader,
+				"in":          "header",
+				"description": "retries with the same key within 24 hours get the response of the first request",
+				"schema":      jsonObject{"type": "string", "maxLength": maxIdempotencyKeyLength},
+			})
+		}
+		if op.EventStream {
+			params = append(params, jsonObject{
+				"name":        "Last-Event-ID",
+				"in":          "header",
+				"description": "id of the last event received, a reconnecting stream starts with the recent events after it",
+				"schema":      jsonObject{"type": "string"},
+			})
+		}
+		if op.Conditional {
+			params = append(params, jsonObject{
+				"name":        "If-Match",
+				"in":          "header",
+				"description": "ETag of the task, the request fails with 412 if the task was changed since",
+				"schema":      jsonObject{"type": "string"},
+			})
+		}
+		if len(params) > 0 {
+			operation["parameters"] = params
+		}
+		if !version.deprecated.IsZero() {
+			operation["deprecated"] = true
+		}
+		if op.Request != nil {
+			operation["requestBody"] = jsonObject{
+				"required": true,
+				"content": jsonObject{
+					"application/json": jsonObject{"schema": jsonSchema(reflect.TypeOf(op.Request), schemas)},
+				},
+			}
+		}
+
+		path := basePath + route
+		if _, ok := paths[path]; !ok {
+			paths[path] = make(jsonObject)
+		}
+		paths[path].(jsonObject)[strings.ToLower(method)] = operation
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(undocumented)
+
+	spec, err := json.MarshalIndent(jsonObject{
+		"openapi": openAPIVersion,
+		"info": jsonObject{
+			"title":   fmt.Sprintf("%s API", cfg.Name),
+			"version": version.name,
+		},
+		"servers": []jsonObject{{"url": cfg.Domain}},
+		"paths":   paths,
+		"components": jsonObject{
+			"schemas": schemas,
+			"securitySchemes": jsonObject{
+				"bearerAuth": jsonObject{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []jsonObject{{"bearerAuth": []string{}}},
+	}, "", "  ")
+	return spec, undocumented, err
+}
+
+func jsonResponse(description string, eventStream bool, payload interface{}, schemas jsonObject) jsonObject {
+	res := jsonObject{"description": description}
+	if payload != nil {
+		contentType := "application/json"
+		if eventStream {
+			contentType = "text/event-stream"
+		}
+		res["content"] = jsonObject{
+			contentType: jsonObject{"schema": jsonSchema(reflect.TypeOf(payload), schemas)},
+		}
+	}
+	return res
+}
+
+// jsonSchema returns the schema of t as encoded by encoding/json. Named structs are added to schemas and referenced.
+func jsonSchema(t reflect.Type, schemas jsonObject) jsonObject {
+	if t.Kind() == reflect.Ptr {
+		return jsonSchema(t.Elem(), schemas)
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return jsonObject{"type": "string", "format": "date-time"}
+	}
+	if values, ok := apiEnums[t]; ok {
+		return jsonObject{"type": "string", "enum": values}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return jsonObject{"type": "string"}
+	case reflect.Bool:
+		return jsonObject{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonObject{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return jsonObject{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return jsonObject{"type": "array", "items": jsonSchema(t.Elem(), schemas)}
+	case reflect.Map:
+		return jsonObject{"type": "object", "additionalProperties": jsonSchema(t.Elem(), schemas)}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return structSchema(t, schemas)
+		}
+		name := schemaName(t)
+		if _, ok := schemas[name]; !ok {
+			// placeholder for recursive types
+			schemas[name] = jsonObject{}
+			schemas[name] = structSchema(t, schemas)
+		}
+		return jsonObject{"$ref": "#/components/schemas/" + name}
+	default:
+		return jsonObject{}
+	}
+}
+
+func structSchema(t reflect.Type, schemas jsonObject) jsonObject {
+	properties := make(jsonObject)
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			// the fields of an embedded struct are encoded as fields of the outer struct
+			embedded := structSchema(f.Type, schemas)
+			for name, property := range embedded["properties"].(jsonObject) {
+				properties[name] = property
+			}
+			if fields, ok := embedded["required"].([]string); ok {
+				required = append(required, fields...)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		name, opts := f.Name, ""
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			name, opts = tag, ""
+			if idx := strings.Index(tag, ","); idx != -1 {
+				name, opts = tag[:idx], tag[idx:]
+			}
+			if name == "" {
+				name = f.Name
+			}
+		}
+		properties[name] = jsonSchema(f.Type, schemas)
+		if !strings.Contains(opts, "omitempty") && f.Type.Kind() != reflect.Ptr {
+			required = append(required, name)
+		}
+	}
+
+	schema := jsonObject{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// schemaName converts createTaskRequest to CreateTaskRequest.
+func schemaName(t reflect.Type) string {
+	return strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+}
+
+func serveOpenAPISpec(spec []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	}
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestAPIOperations checks every route of every api version against its operations, a route without an apiOperation
+// is missing from the openapi spec.
+func TestAPIOperations(t *testing.T) {
+	appCtx := newTestContext(t)
+	for _, version := range apiVersions {
+		t.Run(version.name, func(t *testing.T) {
+			spec, undocumented, err := openAPISpec(appCtx.cfg, "/api/"+version.name, version, apiVersionRoutes(appCtx, version))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, route := range undocumented {
+				t.Errorf("%s has no apiOperation", route)
+			}
+
+			var doc struct {
+				Paths map[string]map[string]json.RawMessage `json:"paths"`
+			}
+			if err := json.Unmarshal(spec, &doc); err != nil {
+				t.Fatal(err)
+			}
+			documented := 0
+			for _, methods := range doc.Paths {
+				documented += len(methods)
+			}
+			// an operation without a route is stale
+			if documented != len(version.operations) {
+				t.Errorf("%d of the %d operations have a route", documented, len(version.operations))
+			}
+		})
+	}
+}
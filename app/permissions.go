@@ -0,0 +1,119 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/render"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+// permissions granted by the roles
+const (
+	permTasksRead      = "tasks:read"
+	permTasksWrite     = "tasks:write"
+	permMembersManage  = "members:manage"
+	permWebhooksManage = "webhooks:manage"
+	permGroupsManage   = "groups:manage"
+)
+
+// allPermissions are the permissions which can be granted to a group.
+var allPermissions = []string{permTasksRead, permTasksWrite, permMembersManage, permWebhooksManage, permGroupsManage}
+
+// defaultRoles are used if the roles file isn't loaded.
+var defaultRoles = []Role{
+	{Name: string(membership.RoleOwner), Permissions: allPermissions},
+	{Name: string(membership.RoleAdmin), Permissions: allPermissions},
+	{Name: string(membership.RoleMember), Permissions: []string{permTasksRead, permTasksWrite}},
+	{Name: string(membership.RoleGuest), Permissions: []string{permTasksRead}},
+}
+
+// rolePermissions is the set of permissions of every role.
+type rolePermissions map[membership.Role]map[string]bool
+
+// newRolePermissions fails on the unknown roles and permissions and if the owner role is missing, a typo in the roles
+// file would leave the owners without access otherwise.
+func newRolePermissions(roles []Role) (rolePermissions, error) {
+	if len(roles) == 0 {
+		roles = defaultRoles
+	}
+	rp := make(rolePermissions)
+	for _, role := range roles {
+		if err := membership.RoleValidator(membership.Role(role.Name)); err != nil {
+			return nil, fmt.Errorf("roles: %v", err)
+		}
+		permissions := make(map[string]bool)
+		for _, permission := range role.Permissions {
+			if !isPermission(permission) {
+				return nil, fmt.Errorf("roles: role %s has the unknown permission %q", role.Name, permission)
+			}
+			permissions[permission] = true
+		}
+		rp[membership.Role(role.Name)] = permissions
+	}
+	if _, ok := rp[membership.RoleOwner]; !ok {
+		return nil, fmt.Errorf("roles: the %s role is missing", membership.RoleOwner)
+	}
+	return rp, nil
+}
+
+// hasPermission reports whether the role of the account in the workspace or one of its groups grants the permission.
+func hasPermission(ctx context.Context, appCtx Context, accountID, workspaceID, permission string) (bool, error) {
+	m, err := appCtx.db.Membership.Query().
+		Where(membership.AccountID(accountID), membership.HasWorkspaceWith(workspace.ID(workspaceID))).
+		WithGroups().
+		Only(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if appCtx.roles[m.Role][permission] {
+		return true, nil
+	}
+	for _, g := range m.Edges.Groups {
+		for _, granted := range g.Permissions {
+			if granted == permission {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// RequirePermission responds with 403 to the accounts whose role in the current workspace doesn't grant the
+// permission. It's used after withWorkspace.
+func (appCtx Context) RequirePermission(permission string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ok, err := hasPermission(r.Context(), appCtx, authn.AccountIDFromContext(r), workspaceIDFromContext(r), permission)
+			if err != nil {
+				render.Render(w, r, ErrInternal(err))
+				return
+			}
+			if !ok {
+				render.Render(w, r, ErrForbidden(fmt.Errorf("the %s permission is required", permission)))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// checkPermission is RequirePermission for the rl.Data handlers, the error is shown on the page.
+func checkPermission(r *http.Request, appCtx Context, permission string) error {
+	ok, err := hasPermission(r.Context(), appCtx, authn.AccountIDFromContext(r), workspaceIDFromContext(r), permission)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !ok {
+		return fmt.Errorf("%w", fmt.Errorf("your role in the workspace doesn't allow this, the %s permission is required", permission))
+	}
+	return nil
+}
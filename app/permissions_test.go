@@ -0,0 +1,173 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adnaan/renderlayout"
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+)
+
+func TestNewRolePermissions(t *testing.T) {
+	owner := Role{Name: string(membership.RoleOwner), Permissions: allPermissions}
+	for name, tt := range map[string]struct {
+		roles []Role
+		valid bool
+	}{
+		"default":            {nil, true},
+		"owner only":         {[]Role{owner}, true},
+		"unknown role":       {[]Role{owner, {Name: "superuser"}}, false},
+		"unknown permission": {[]Role{owner, {Name: string(membership.RoleGuest), Permissions: []string{"task:read"}}}, false},
+		"missing owner":      {[]Role{{Name: string(membership.RoleAdmin), Permissions: allPermissions}}, false},
+	} {
+		if _, err := newRolePermissions(tt.roles); (err == nil) != tt.valid {
+			t.Errorf("%s: got %v, want valid %v", name, err, tt.valid)
+		}
+	}
+}
+
+// newTestGuest adds a guest account to the personal workspace of the owner and returns its id, its token and the
+// workspace id.
+func newTestGuest(t *testing.T, appCtx Context, ownerID string) (string, string, string) {
+	t.Helper()
+	personal, err := ensurePersonalWorkspace(context.Background(), appCtx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	guestID, guestToken := newTestAccount(t, appCtx, "guest@example.com")
+	_, err = appCtx.db.Membership.Create().
+		SetID(shortuuid.New()).
+		SetAccountID(guestID).
+		SetEmail("guest@example.com").
+		SetRole(membership.RoleGuest).
+		SetWorkspace(personal).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return guestID, guestToken, personal.ID
+}
+
+func TestGuestsCanOnlyReadTheAPI(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	ownerID, ownerToken := newTestAccount(t, appCtx, "owner@example.com")
+	_, guestToken, workspaceID := newTestGuest(t, appCtx, ownerID)
+
+	owned := new(taskResponse)
+	apiRequest(t, api, ownerToken, http.MethodPost, "/tasks", map[string]string{"text": "owned task"}, owned)
+
+	text := "changed"
+	for _, tt := range []struct {
+		method string
+		path   string
+		body   interface{}
+		want   int
+	}{
+		{http.MethodGet, "/tasks", nil, http.StatusOK},
+		{http.MethodGet, "/tasks/" + owned.ID, nil, http.StatusOK},
+		{http.MethodPost, "/tasks", map[string]string{"text": "guest task"}, http.StatusForbidden},
+		{http.MethodPatch, "/tasks/" + owned.ID, map[string]string{"text": text}, http.StatusForbidden},
+		{http.MethodPut, "/tasks/" + owned.ID + "/status", map[string]string{"status": "done"}, http.StatusForbidden},
+		{http.MethodDelete, "/tasks/" + owned.ID, nil, http.StatusForbidden},
+		{http.MethodPost, "/tasks/batch", batchTasksRequest{Operations: []batchTaskOperation{{Op: createBatchOp, Text: &text}}}, http.StatusForbidden},
+	} {
+		req := newAPIRequest(t, guestToken, tt.method, tt.path, tt.body)
+		req.Header.Set(workspaceHeader, workspaceID)
+		if w := serve(api, req); w.Code != tt.want {
+			t.Errorf("%s %s: got %d, want %d", tt.method, tt.path, w.Code, tt.want)
+		}
+	}
+
+	stored, err := appCtx.db.Task.Get(context.Background(), owned.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Text != "owned task" {
+		t.Fatalf("the guest changed the task to %q", stored.Text)
+	}
+}
+
+func TestGuestsCanOnlyReadTheApp(t *testing.T) {
+	appCtx := newTestContext(t)
+	ownerID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	guestID, _, workspaceID := newTestGuest(t, appCtx, ownerID)
+	owned, err := newTask(context.Background(), appCtx, workspaceID, "owned task", taskFieldsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	guestRequest := func(method, path string, form url.Values) *http.Request {
+		req := newTestWorkspaceRequest(t, appCtx, guestID, method, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req.WithContext(context.WithValue(req.Context(), workspaceIDKey, workspaceID))
+	}
+
+	// the app page is readable, without the forms which change the tasks
+	read := appCtx.RequirePermission(permTasksRead)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	if w := serve(read, guestRequest(http.MethodGet, "/app", nil)); w.Code != http.StatusOK {
+		t.Fatalf("got %d, want the guest to read the app", w.Code)
+	}
+	data, err := listTasks(appCtx)(httptest.NewRecorder(), guestRequest(http.MethodGet, "/app", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data["can_write_tasks"] != false {
+		t.Fatal("the guest gets the forms which change the tasks")
+	}
+
+	for name, action := range map[string]taskFormAction{
+		"create": createNewTask(appCtx),
+		"edit":   editTask(appCtx),
+		"delete": deleteTask(appCtx),
+	} {
+		req := withURLParam(guestRequest(http.MethodPost, "/app/tasks", url.Values{"Text": {"guest task"}}), "id", owned.ID)
+		if event, _, err := action(httptest.NewRecorder(), req); err == nil || event != nil {
+			t.Errorf("%s: got no error, want the guest to be refused", name)
+		}
+	}
+
+	tasks, err := appCtx.db.Task.Query().All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].Text != "owned task" {
+		t.Fatalf("got %d tasks, want the owner's task unchanged", len(tasks))
+	}
+}
+
+func TestWebhookSectionIsHiddenFromNonManagers(t *testing.T) {
+	developer, err := template.ParseFiles(filepath.Join("..", "templates", "partials", "developer.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoints := []*models.WebhookEndpoint{{ID: "endpoint-1", URL: "https://example.com/hook", Secret: "whsec_secret"}}
+	for _, manage := range []bool{true, false} {
+		var buf bytes.Buffer
+		err := developer.ExecuteTemplate(&buf, "developer", renderlayout.D{
+			"can_manage_webhooks": manage,
+			"webhook_endpoints":   endpoints,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		page := buf.String()
+		if strings.Contains(page, "Webhooks") != manage || strings.Contains(page, "https://example.com/hook") != manage {
+			t.Errorf("can manage %v: got the webhook section %v", manage, strings.Contains(page, "Webhooks"))
+		}
+		// the secret is only shown once, right after its creation
+		if strings.Contains(page, "whsec_secret") {
+			t.Errorf("can manage %v: the secret of an endpoint is shown", manage)
+		}
+	}
+}
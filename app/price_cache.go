@@ -0,0 +1,68 @@
+package app
+
+import (
+	"sync"
+	"time"
+)
+
+// priceCache caches the current price id of accounts in memory for a limited time.
+type priceCache struct {
+	ttl       time.Duration
+	mu        sync.RWMutex
+	entries   map[string]priceCacheEntry
+	lastSweep time.Time
+}
+
+type priceCacheEntry struct {
+	priceID   string
+	expiresAt time.Time
+}
+
+func newPriceCache(ttl time.Duration) *priceCache {
+	return &priceCache{
+		ttl:     ttl,
+		entries: make(map[string]priceCacheEntry),
+	}
+}
+
+func (c *priceCache) get(accountID string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[accountID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return "", false
+	}
+	return entry.priceID, true
+}
+
+func (c *priceCache) set(accountID, priceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	// drop expired entries now and then so the cache doesn't grow with every account ever seen
+	if now.Sub(c.lastSweep) > c.ttl {
+		live := make(map[string]priceCacheEntry, len(c.entries))
+		for k, entry := range c.entries {
+			if !now.After(entry.expiresAt) {
+				live[k] = entry
+			}
+		}
+		c.entries = live
+		c.lastSweep = now
+	}
+	c.entries[accountID] = priceCacheEntry{priceID: priceID, expiresAt: now.Add(c.ttl)}
+}
+
+// del expires the cached price id of the account so that it's read again on the next get.
+func (c *priceCache) del(accountID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[accountID] = priceCacheEntry{}
+}
+
+// clear expires the cached price ids of all the accounts, used when the account of a changed subscription is unknown.
+func (c *priceCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]priceCacheEntry)
+}
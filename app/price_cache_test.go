@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stripe/stripe-go/v72"
+)
+
+func TestPriceCache(t *testing.T) {
+	cache := newPriceCache(time.Hour)
+	if _, ok := cache.get("a"); ok {
+		t.Fatal("got a price of an account which wasn't cached")
+	}
+
+	cache.set("a", "price_pro")
+	// the free plan is cached as an empty price id
+	cache.set("b", "")
+	if priceID, ok := cache.get("a"); !ok || priceID != "price_pro" {
+		t.Fatalf("got %q %v, want price_pro", priceID, ok)
+	}
+	if priceID, ok := cache.get("b"); !ok || priceID != "" {
+		t.Fatalf("got %q %v, want the cached free plan", priceID, ok)
+	}
+
+	cache.del("a")
+	if _, ok := cache.get("a"); ok {
+		t.Fatal("got a deleted price")
+	}
+
+	// an expired entry isn't returned and it's dropped by the next set once the ttl passed
+	cache.entries["b"] = priceCacheEntry{priceID: "price_old", expiresAt: time.Now().Add(-time.Second)}
+	if _, ok := cache.get("b"); ok {
+		t.Fatal("got an expired price")
+	}
+	cache.lastSweep = time.Now().Add(-2 * time.Hour)
+	cache.set("c", "price_pro")
+	if _, ok := cache.entries["b"]; ok {
+		t.Fatal("the expired entry wasn't dropped")
+	}
+
+	cache.clear()
+	if _, ok := cache.get("c"); ok {
+		t.Fatal("got a price after the cache was cleared")
+	}
+}
+
+func TestCurrentPriceIDReadsLocalSubscriptions(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.prices = newPriceCache(time.Hour)
+	ctx := context.Background()
+
+	const owner = "11111111-1111-1111-1111-111111111111"
+	sub, err := appCtx.db.Subscription.Create().
+		SetID("sub_1").
+		SetBillingID("cus_1").
+		SetOwner(owner).
+		SetStatus(string(stripe.SubscriptionStatusActive)).
+		SetPriceID("price_pro").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a subscription whose checkout wasn't received yet is found by the customer id of the account
+	_, err = appCtx.db.Subscription.Create().
+		SetID("sub_2").
+		SetBillingID("cus_2").
+		SetStatus(string(stripe.SubscriptionStatusTrialing)).
+		SetPriceID("price_team").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		accountID, billingID, want string
+	}{
+		{owner, "", "price_pro"},
+		{"22222222-2222-2222-2222-222222222222", "cus_2", "price_team"},
+		{"33333333-3333-3333-3333-333333333333", "", ""},
+		{"", "cus_2", ""},
+	} {
+		priceID, err := currentPriceID(ctx, appCtx, tt.accountID, tt.billingID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if priceID != tt.want {
+			t.Errorf("%s %s: got %q, want %q", tt.accountID, tt.billingID, priceID, tt.want)
+		}
+	}
+
+	// the price is cached until the subscription's webhook expires it
+	_, err = sub.Update().SetStatus(string(stripe.SubscriptionStatusCanceled)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if priceID, _ := currentPriceID(ctx, appCtx, owner, ""); priceID != "price_pro" {
+		t.Fatalf("got %q, want the cached price", priceID)
+	}
+	appCtx.prices.del(owner)
+	if priceID, _ := currentPriceID(ctx, appCtx, owner, ""); priceID != "" {
+		t.Fatalf("got %q, want the canceled subscription to be ignored", priceID)
+	}
+}
@@ -3,14 +3,20 @@ package app
 import (
 	"context"
 	"fmt"
+	"html/template"
 	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hako/branca"
 
 	"github.com/adnaan/authn"
+	authnmodels "github.com/adnaan/authn/models"
 
 	"github.com/adnaan/gomodest-starter/app/gen/models"
+	// the defaults and hooks of the schema are registered by the runtime package since the schema has hooks
+	_ "github.com/adnaan/gomodest-starter/app/gen/models/runtime"
 
 	"github.com/go-playground/form"
 
@@ -32,7 +38,18 @@ type Context struct {
 	cfg         Config
 	formDecoder *form.Decoder
 	db          *models.Client
+	accounts    *authnmodels.Client
 	branca      *branca.Branca
+	prices      *priceCache
+	webhooks    map[string]WebhookSource
+	hub         *taskHub
+	taskStreams *template.Template
+	mailer      *mailer
+	roles       rolePermissions
+	// delivers the outgoing webhooks, see newWebhookClient
+	webhookClient *http.Client
+	// set while db is a transaction, see withTx
+	inTx bool
 }
 
 type APIRoute struct {
@@ -54,18 +71,40 @@ func Router(ctx context.Context, cfg Config) chi.Router {
 		panic(err)
 	}
 
+	accounts, err := authnmodels.Open(cfg.Driver, cfg.DataSource)
+	if err != nil {
+		panic(err)
+	}
+
 	appCtx := Context{
 		db:          db,
+		accounts:    accounts,
 		cfg:         cfg,
 		formDecoder: form.NewDecoder(),
 		branca:      branca.NewBranca(cfg.APIMasterSecret),
+		prices:      newPriceCache(time.Duration(cfg.PlanCacheTTLSecs) * time.Second),
+		webhooks:    newWebhookSources(cfg),
+		hub:         newTaskHub(ctx),
+		mailer:      newMailer(cfg),
+
+		webhookClient: newWebhookClient(cfg.WebhookAllowPrivateURLs),
+	}
+
+	appCtx.taskStreams, err = parseTaskStreamTemplates()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	appCtx.roles, err = newRolePermissions(cfg.Roles)
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	authnConfig := authn.Config{
 		Driver:        cfg.Driver,
 		Datasource:    cfg.DataSource,
 		SessionSecret: cfg.SessionSecret,
-		SendMail:      sendEmailFunc(cfg),
+		SendMail:      sendEmailFunc(cfg, appCtx.mailer),
 		GothProviders: []goth.Provider{
 			google.New(
 				cfg.GoogleClientID,
@@ -77,6 +116,13 @@ func Router(ctx context.Context, cfg Config) chi.Router {
 	}
 
 	appCtx.authn = authn.New(ctx, authnConfig)
+	// the accounts table is created by authn
+	if err := migrateAPIKeys(ctx, appCtx); err != nil {
+		log.Fatal(err)
+	}
+
+	go retryWebhookEvents(ctx, appCtx)
+	go retryWebhookDeliveries(ctx, appCtx)
 
 	// logger
 	logger := httplog.NewLogger(cfg.Name,
@@ -98,7 +144,7 @@ func Router(ctx context.Context, cfg Config) chi.Router {
 
 	// middlewares
 	r := chi.NewRouter()
-	r.Use(middleware.Compress(5))
+	r.Use(compressExceptEventStreams(middleware.Compress(5)))
 	r.Use(middleware.Heartbeat(cfg.HealthPath))
 	r.Use(middleware.Recoverer)
 	r.Use(httplog.RequestLogger(logger))
@@ -107,7 +153,7 @@ func Router(ctx context.Context, cfg Config) chi.Router {
 	r.Route("/", func(r chi.Router) {
 		r.Post("/webhook/{source}", handleWebhook(appCtx))
 		r.Get("/", index("home"))
-		r.Get("/signup", index("account/signup"))
+		r.Get("/signup", index("account/signup", invitedSignupPage(appCtx)))
 		r.Post("/signup", index("account/signup", signupPage(appCtx)))
 		r.Get("/confirm/{token}", index("account/confirmed", confirmEmailPage(appCtx)))
 		r.Get("/login", index("account/login", loginPage(appCtx)))
@@ -129,16 +175,27 @@ func Router(ctx context.Context, cfg Config) chi.Router {
 			acc.Logout(w, r)
 		})
 		r.Get("/change/{token}", index("account/changed", confirmEmailChangePage(appCtx)))
+		r.Get("/invite/{token}", index("account/invite", invitationPage(appCtx)))
+		r.With(appCtx.authn.IsAuthenticated).Post("/invite/{token}", index("account/invite", respondToInvitationPage(appCtx)))
 	})
 
 	// authenticated
 	r.Route("/account", func(r chi.Router) {
 		r.Use(appCtx.authn.IsAuthenticated)
+		r.Use(withWorkspace(appCtx))
 		r.Get("/", index("account/main", accountPage(appCtx)))
 		r.Post("/", index("account/main", accountPageSubmit(appCtx)))
 		r.Post("/delete", index("account/main", deleteAccount(appCtx)))
-
-		r.Post("/checkout", handleCreateCheckoutSession(appCtx))
+		r.Post("/webhooks", index("account/main", createWebhookEndpoint(appCtx)))
+		r.Post("/webhooks/{id}/delete", index("account/main", deleteWebhookEndpoint(appCtx)))
+		r.Post("/workspaces", index("account/main", createWorkspacePage(appCtx)))
+		r.Post("/workspaces/{id}/switch", index("account/main", switchWorkspace(appCtx)))
+		r.Post("/invitations", index("account/main", createInvitationsPage(appCtx)))
+		r.Post("/invitations/{id}/revoke", index("account/main", revokeInvitationPage(appCtx)))
+		r.Post("/invitations/{id}/resend", index("account/main", resendInvitationPage(appCtx)))
+		r.Get("/audit.jsonl", exportAuditEvents(appCtx))
+
+		r.With(idempotent(appCtx)).Post("/checkout", handleCreateCheckoutSession(appCtx))
 		r.Get("/checkout/success", handleCheckoutSuccess(appCtx))
 		r.Get("/checkout/cancel", handleCheckoutCancel(appCtx))
 		r.Get("/subscription/manage", handleManageSubscription(appCtx))
@@ -146,25 +203,41 @@ func Router(ctx context.Context, cfg Config) chi.Router {
 
 	r.Route("/app", func(r chi.Router) {
 		r.Use(appCtx.authn.IsAuthenticated)
+		r.Use(withWorkspace(appCtx))
+		r.Use(appCtx.RequirePermission(permTasksRead))
 		r.Get("/", index("app", listTasks(appCtx)))
-		r.Post("/tasks/new", index("app", createNewTask(appCtx), listTasks(appCtx)))
-		r.Post("/tasks/{id}/edit", index("app", editTask(appCtx), listTasks(appCtx)))
-		r.Post("/tasks/{id}/delete", index("app", deleteTask(appCtx), listTasks(appCtx)))
+		r.Get("/stream", streamTasks(appCtx))
+		r.Post("/tasks/new", taskForm(appCtx, index, createNewTask(appCtx)))
+		r.Post("/tasks/{id}/edit", taskForm(appCtx, index, editTask(appCtx)))
+		r.Post("/tasks/{id}/delete", taskForm(appCtx, index, deleteTask(appCtx)))
 	})
 
-	r.Route("/api", func(r chi.Router) {
-		r.Use(appCtx.authn.IsAuthenticated)
-		r.Use(middleware.AllowContentType("application/json"))
-		r.Route("/tasks", func(r chi.Router) {
-			r.Get("/", list(appCtx))
-			r.Post("/", create(appCtx))
-		})
-		r.Route("/tasks/{id}", func(r chi.Router) {
-			r.Put("/status", updateStatus(appCtx))
-			r.Put("/text", updateText(appCtx))
-			r.Delete("/", delete(appCtx))
-		})
-	})
+	for _, version := range apiVersions {
+		handler, err := apiVersionRouter(appCtx, version)
+		if err != nil {
+			log.Fatal(err)
+		}
+		r.Mount("/api/"+version.name, handler)
+		// the bare /api is an alias of the current version
+		if version.name == currentAPIVersion {
+			r.Mount("/api", handler)
+		}
+	}
 
 	return r
 }
+
+// compressExceptEventStreams skips compressing the responses to EventSource requests. Besides there's little to
+// gain for small events, the compressing writer hides the connection's write deadline which the streams clear.
+func compressExceptEventStreams(compress func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		compressed := compress(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			compressed.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// APIToken holds the schema definition for the APIToken entity.
+// It stores the sha256 hash of an account's api key so that a bearer token is found with an indexed lookup.
+type APIToken struct {
+	ent.Schema
+}
+
+func (APIToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_tokens"},
+	}
+}
+
+// Fields of the APIToken.
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		// an account has a single api token, regenerating it replaces the row
+		field.String("owner").Unique(),
+		field.String("key_hash").Unique(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the APIToken.
+func (APIToken) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/hook"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity, a record of a security or billing event. Audit
+// events are append-only, none of the fields can be updated.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_events"},
+	}
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		// the account the event is about, empty if it's unknown like for a failed login with an unknown email
+		field.String("account_id").Optional().Immutable(),
+		// the account id which caused the event or the name of the system like webhook:stripe
+		field.String("actor").Immutable(),
+		field.String("action").Immutable(),
+		field.String("ip").Optional().Immutable(),
+		field.String("user_agent").Optional().Immutable(),
+		// the changed fields as {"field": {"from": old, "to": new}} or the details of an event which changes nothing
+		field.JSON("diff", map[string]interface{}{}).Optional().Immutable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Hooks of the AuditEvent.
+func (AuditEvent) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// the rows are never changed or removed, even by the code which bypasses the immutable fields
+		hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "created_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Group holds the schema definition for the Group entity, a named set of members of a workspace. Tasks can be
+// assigned to a group and its permissions are granted to its members on top of their roles.
+type Group struct {
+	ent.Schema
+}
+
+func (Group) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "groups"},
+	}
+}
+
+// Fields of the Group.
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("name"),
+		field.Strings("permissions").Optional(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Group.
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("workspace").Unique(),
+	}
+}
+
+// Edges of the Group.
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).Ref("groups").Unique().Required(),
+		edge.To("members", Membership.Type),
+		edge.From("tasks", Task.Type).Ref("group"),
+	}
+}
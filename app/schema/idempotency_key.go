@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It stores the response to a request sent with an Idempotency-Key header so that retries can be answered with it.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "idempotency_keys"},
+	}
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner"),
+		field.String("key"),
+		field.String("request_hash"),
+		field.Int("status_code").Optional(),
+		// the response headers which are replayed along with the response
+		field.JSON("headers", http.Header{}).Optional(),
+		field.Bytes("response").Optional(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+// Edges of the IdempotencyKey.
+func (IdempotencyKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invitation holds the schema definition for the Invitation entity which invites an email to join a workspace.
+type Invitation struct {
+	ent.Schema
+}
+
+func (Invitation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "invitations"},
+	}
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("email"),
+		field.Enum("role").Values("admin", "member", "guest").Default("member"),
+		// signed into the invitation link along with the id, rotated on resend to invalidate the previous link
+		field.String("nonce").Sensitive(),
+		// account id of the inviter
+		field.String("invited_by"),
+		field.Enum("status").Values("pending", "accepted", "declined", "revoked").Default("pending"),
+		field.Time("expires_at"),
+		field.Time("responded_at").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes of the Invitation.
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").Edges("workspace"),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).Ref("invitations").Unique().Required(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Membership holds the schema definition for the Membership entity which gives an account a role in a workspace.
+type Membership struct {
+	ent.Schema
+}
+
+func (Membership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "memberships"},
+	}
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("account_id"),
+		// copied from the account to list the members without querying the authn tables
+		field.String("email"),
+		field.Enum("role").Values("owner", "admin", "member", "guest").Default("member"),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes of the Membership.
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id").Edges("workspace").Unique(),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).Ref("memberships").Unique().Required(),
+		edge.From("groups", Group.Type).Ref("members"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Subscription holds the schema definition for the Subscription entity.
+// It mirrors a Stripe subscription and is kept up to date by the Stripe webhook.
+type Subscription struct {
+	ent.Schema
+}
+
+func (Subscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "subscriptions"},
+	}
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("billing_id"),
+		field.String("owner").Optional(),
+		field.String("status").Default("incomplete"),
+		field.String("price_id").Optional(),
+		field.Time("current_period_end").Optional().Nillable(),
+		field.Bool("cancel_at_period_end").Default(false),
+		field.Time("last_event_at").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("billing_id"),
+		index.Fields("owner"),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Tag holds the schema definition for the Tag entity. Tags are created on the fly by name when they are set on a task.
+type Tag struct {
+	ent.Schema
+}
+
+func (Tag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tags"},
+	}
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		// id of the workspace the tag belongs to
+		field.String("owner"),
+		field.String("name"),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner", "name").Unique(),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tasks", Task.Type).Ref("tags"),
+	}
+}
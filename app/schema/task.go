@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -25,15 +26,26 @@ func (Task) Annotations() []schema.Annotation {
 func (Task) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id"),
+		// id of the workspace the task belongs to
 		field.String("owner"),
 		field.Text("text"),
 		field.Enum("status").Values("todo", "inprogress", "done").Default("todo").Optional(),
 		field.Time("created_at").Immutable().Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		// incremented by every change, used as the ETag of the task
+		field.Int("version").Default(1),
+		field.Time("due_at").Optional().Nillable(),
+		field.Enum("priority").Values("low", "medium", "high").Default("medium"),
+		// manual order of the tasks of an owner, new tasks are added at the end
+		field.Int("position").Default(0),
 	}
 }
 
 // Edges of the Task.
 func (Task) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("tags", Tag.Type),
+		// the group the task is assigned to
+		edge.To("group", Group.Type).Unique(),
+	}
 }
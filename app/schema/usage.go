@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Usage holds the schema definition for the Usage entity.
+// It counts the usage of a plan feature by an account within a billing period.
+type Usage struct {
+	ent.Schema
+}
+
+func (Usage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "usages"},
+	}
+}
+
+// Fields of the Usage.
+func (Usage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner"),
+		field.String("feature_id"),
+		field.String("period"),
+		field.Int64("count").Default(0),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Usage.
+func (Usage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner", "feature_id", "period").Unique(),
+	}
+}
+
+// Edges of the Usage.
+func (Usage) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// It logs the delivery of an event to a webhook endpoint.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_deliveries"},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("endpoint_id"),
+		field.String("event_id"),
+		field.String("event_type"),
+		field.Bytes("payload"),
+		field.Int("attempts").Default(0),
+		field.Int("status_code").Optional(),
+		field.Text("error").Optional(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		// set while the delivery is attempted by a request or a retry worker, see claimWebhookDelivery
+		field.Time("claimed_at").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("endpoint_id"),
+		index.Fields("next_attempt_at"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint entity.
+// Task events of the owner are posted to the endpoint url.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+func (WebhookEndpoint) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_endpoints"},
+	}
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		// id of the workspace the endpoint belongs to
+		field.String("owner"),
+		field.String("url"),
+		field.String("secret").Sensitive(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner"),
+	}
+}
+
+// Edges of the WebhookEndpoint.
+func (WebhookEndpoint) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookEvent holds the schema definition for the WebhookEvent entity.
+// Every verified incoming webhook event is stored before it's processed.
+type WebhookEvent struct {
+	ent.Schema
+}
+
+func (WebhookEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_events"},
+	}
+}
+
+// Fields of the WebhookEvent.
+func (WebhookEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("source"),
+		field.String("event_id"),
+		field.String("type"),
+		field.Bytes("payload"),
+		field.Time("received_at").Immutable().Default(time.Now),
+		field.Time("processed_at").Optional().Nillable(),
+		field.Text("error").Optional(),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		// set while the event is processed by a request or a retry worker, see claimWebhookEvent
+		field.Time("claimed_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the WebhookEvent.
+func (WebhookEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source", "event_id").Unique(),
+		index.Fields("next_attempt_at"),
+	}
+}
+
+// Edges of the WebhookEvent.
+func (WebhookEvent) Edges() []ent.Edge {
+	return nil
+}
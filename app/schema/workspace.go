@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Workspace holds the schema definition for the Workspace entity. The tasks, tags and webhook endpoints of a workspace
+// are shared by its members. Every account has a personal workspace with the id of the account.
+type Workspace struct {
+	ent.Schema
+}
+
+func (Workspace) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "workspaces"},
+	}
+}
+
+// Fields of the Workspace.
+func (Workspace) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("name"),
+		field.Time("created_at").Immutable().Default(time.Now),
+		// written by lockWorkspace to hold the row lock of the workspace in a transaction
+		field.Time("locked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Workspace.
+func (Workspace) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", Membership.Type),
+		edge.To("invitations", Invitation.Type),
+		edge.To("groups", Group.Type),
+	}
+}
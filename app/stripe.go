@@ -15,8 +15,7 @@ import (
 // modified from https://github.com/stripe-samples/checkout-single-subscription/blob/master/server/go/server.go
 
 const (
-	billingIDKey      = "billing_id"
-	currentPriceIDKey = "current_price_id"
+	billingIDKey = "billing_id"
 )
 
 type errResponse struct {
@@ -61,6 +60,9 @@ func handleCreateCheckoutSession(appCtx Context) http.HandlerFunc {
 			},
 		}
 
+		// links the subscription created by the checkout to the account when handling the webhook
+		params.ClientReferenceID = stripe.String(account.ID().String())
+
 		s, err := session.New(params)
 		if err != nil {
 			log.Printf("session.New: %v", err)
@@ -69,6 +71,9 @@ func handleCreateCheckoutSession(appCtx Context) http.HandlerFunc {
 			return
 		}
 
+		recordAuditEvent(r, appCtx, account.ID().String(), account.ID().String(), auditCheckoutStarted,
+			auditDiff{"price": req.Price, "session_id": s.ID})
+
 		render.JSON(w, r, struct {
 			SessionID string `json:"sessionId"`
 		}{SessionID: s.ID})
@@ -128,10 +133,7 @@ func handleManageSubscription(appCtx Context) http.HandlerFunc {
 		}
 
 		// expect plan to be change
-		err = account.Attributes().Session().Del(w, currentPriceIDKey)
-		if err != nil {
-			log.Printf("account.Attributes().Session().Del(), %s failed  err %v\n", currentPriceIDKey, err)
-		}
+		appCtx.prices.del(account.ID().String())
 		billingID, ok := account.Attributes().Map().String(billingIDKey)
 		if !ok {
 			log.Printf(" %s not found \n", billingIDKey)
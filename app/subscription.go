@@ -0,0 +1,231 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/stripe/stripe-go/v72"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/subscription"
+)
+
+// subscriptionState is the local copy of a stripe subscription carried by a webhook event.
+type subscriptionState struct {
+	ID                string
+	BillingID         string
+	Owner             string
+	Status            string
+	PriceID           string
+	CurrentPeriodEnd  int64
+	CancelAtPeriodEnd *bool
+	// set by the customer.subscription events which carry the whole subscription, only those are ordered by the
+	// last_event_at watermark
+	Full bool
+}
+
+// handleStripeEvent updates the local subscription state from a verified stripe event.
+// Unhandled event types are ignored.
+func handleStripeEvent(ctx context.Context, appCtx Context, event stripe.Event) error {
+	if event.Data == nil {
+		return fmt.Errorf("event %s has no data", event.ID)
+	}
+	eventAt := time.Unix(event.Created, 0)
+	var state subscriptionState
+
+	switch event.Type {
+	case "checkout.session.completed":
+		var s stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Raw, &s); err != nil {
+			return fmt.Errorf("parsing checkout session: %w", err)
+		}
+		if s.Subscription == nil || s.Customer == nil {
+			return nil
+		}
+		state = subscriptionState{
+			ID:        s.Subscription.ID,
+			BillingID: s.Customer.ID,
+			Owner:     s.ClientReferenceID,
+		}
+
+	case "customer.subscription.created", "customer.subscription.updated", "customer.subscription.deleted":
+		var s stripe.Subscription
+		if err := json.Unmarshal(event.Data.Raw, &s); err != nil {
+			return fmt.Errorf("parsing subscription: %w", err)
+		}
+		state = subscriptionState{
+			ID:                s.ID,
+			Status:            string(s.Status),
+			CurrentPeriodEnd:  s.CurrentPeriodEnd,
+			CancelAtPeriodEnd: &s.CancelAtPeriodEnd,
+			Full:              true,
+		}
+		if s.Customer != nil {
+			state.BillingID = s.Customer.ID
+		}
+		if s.Items != nil {
+			for _, item := range s.Items.Data {
+				if item.Price != nil {
+					state.PriceID = item.Price.ID
+				}
+			}
+		}
+		if event.Type == "customer.subscription.deleted" {
+			state.Status = string(stripe.SubscriptionStatusCanceled)
+		}
+
+	case "invoice.payment_failed":
+		var i stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &i); err != nil {
+			return fmt.Errorf("parsing invoice: %w", err)
+		}
+		if i.Subscription == nil || i.Customer == nil {
+			return nil
+		}
+		state = subscriptionState{
+			ID:        i.Subscription.ID,
+			BillingID: i.Customer.ID,
+			Status:    string(stripe.SubscriptionStatusPastDue),
+		}
+
+	default:
+		log.Printf("webhook: ignoring stripe event %s of type %s\n", event.ID, event.Type)
+		return nil
+	}
+
+	sub, err := saveSubscription(ctx, appCtx.db, state, eventAt)
+	if err != nil {
+		return err
+	}
+	// the plan of the account might have changed, the cache is keyed by account so all of it is expired if the
+	// subscription's account isn't known yet
+	if sub.Owner != "" {
+		appCtx.prices.del(sub.Owner)
+	} else {
+		appCtx.prices.clear()
+	}
+	return nil
+}
+
+// saveSubscription creates or updates the subscription with the non empty values of state.
+// Stripe doesn't guarantee the order of events, so a full subscription event older than the last applied one is
+// skipped. The partial events don't move the watermark, otherwise an earlier full event delivered after them would be
+// skipped and its status and price lost. Only the status of an older partial event is dropped.
+func saveSubscription(ctx context.Context, db *models.Client, state subscriptionState, eventAt time.Time) (*models.Subscription, error) {
+	if state.ID == "" {
+		return nil, fmt.Errorf("subscription id is empty")
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		existing, err := db.Subscription.Get(ctx, state.ID)
+		if err != nil && !models.IsNotFound(err) {
+			return nil, err
+		}
+
+		if existing == nil {
+			create := db.Subscription.Create().
+				SetID(state.ID).
+				SetBillingID(state.BillingID)
+			if state.Full {
+				create.SetLastEventAt(eventAt)
+			}
+			if state.Owner != "" {
+				create.SetOwner(state.Owner)
+			}
+			if state.Status != "" {
+				create.SetStatus(state.Status)
+			}
+			if state.PriceID != "" {
+				create.SetPriceID(state.PriceID)
+			}
+			if state.CurrentPeriodEnd != 0 {
+				create.SetCurrentPeriodEnd(time.Unix(state.CurrentPeriodEnd, 0))
+			}
+			if state.CancelAtPeriodEnd != nil {
+				create.SetCancelAtPeriodEnd(*state.CancelAtPeriodEnd)
+			}
+			sub, err := create.Save(ctx)
+			if models.IsConstraintError(err) {
+				// created by a concurrent delivery, update it instead
+				continue
+			}
+			return sub, err
+		}
+
+		stale := existing.LastEventAt != nil && eventAt.Before(*existing.LastEventAt)
+		if stale && state.Full {
+			log.Printf("webhook: skipping stale event for subscription %s\n", state.ID)
+			return existing, nil
+		}
+
+		update := existing.Update()
+		if state.Full {
+			update.SetLastEventAt(eventAt)
+		}
+		if stale {
+			state.Status = ""
+		}
+		if state.BillingID != "" {
+			update.SetBillingID(state.BillingID)
+		}
+		if state.Owner != "" {
+			update.SetOwner(state.Owner)
+		}
+		if state.Status != "" {
+			update.SetStatus(state.Status)
+		}
+		if state.PriceID != "" {
+			update.SetPriceID(state.PriceID)
+		}
+		if state.CurrentPeriodEnd != 0 {
+			update.SetCurrentPeriodEnd(time.Unix(state.CurrentPeriodEnd, 0))
+		}
+		if state.CancelAtPeriodEnd != nil {
+			update.SetCancelAtPeriodEnd(*state.CancelAtPeriodEnd)
+		}
+		return update.Save(ctx)
+	}
+
+	return nil, fmt.Errorf("saving subscription %s failed", state.ID)
+}
+
+// currentPriceID returns the price id of the account's active subscription or an empty string if it has none.
+// It reads the subscriptions maintained by the stripe webhook and caches the result for the configured time.
+func currentPriceID(ctx context.Context, appCtx Context, accountID, billingID string) (string, error) {
+	if accountID == "" {
+		return "", nil
+	}
+	if priceID, ok := appCtx.prices.get(accountID); ok {
+		return priceID, nil
+	}
+
+	owned := []predicate.Subscription{subscription.Owner(accountID)}
+	if billingID != "" {
+		owned = append(owned, subscription.BillingID(billingID))
+	}
+
+	sub, err := appCtx.db.Subscription.Query().
+		Where(
+			subscription.Or(owned...),
+			subscription.StatusIn(
+				string(stripe.SubscriptionStatusActive),
+				string(stripe.SubscriptionStatusTrialing),
+			),
+		).
+		Order(models.Desc(subscription.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil && !models.IsNotFound(err) {
+		return "", err
+	}
+
+	var priceID string
+	if sub != nil {
+		priceID = sub.PriceID
+	}
+	appCtx.prices.set(accountID, priceID)
+	return priceID, nil
+}
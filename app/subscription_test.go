@@ -0,0 +1,241 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/webhook"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+const testStripeWebhookSecret = "whsec_test"
+
+// signedStripeRequest posts the fixture to the stripe webhook signed like stripe does, see
+// webhook.GenerateTestSignedPayload in newer versions of stripe-go.
+func signedStripeRequest(t *testing.T, fixture string) *http.Request {
+	t.Helper()
+	payload, err := ioutil.ReadFile(filepath.Join("testdata", "stripe", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	signature := webhook.ComputeSignature(now, payload, testStripeWebhookSecret)
+	req := httptest.NewRequest(http.MethodPost, "/webhook/stripe", bytes.NewReader(payload))
+	req.Header.Set("Stripe-Signature", fmt.Sprintf("t=%d,v1=%x", now.Unix(), signature))
+	return req
+}
+
+func newTestWebhookRouter(appCtx Context) http.Handler {
+	r := chi.NewRouter()
+	r.Post("/webhook/{source}", handleWebhook(appCtx))
+	return r
+}
+
+func TestStripeWebhookSubscriptionState(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	router := newTestWebhookRouter(appCtx)
+
+	type want struct {
+		owner             string
+		status            string
+		priceID           string
+		currentPeriodEnd  int64
+		cancelAtPeriodEnd bool
+		lastEventAt       int64
+	}
+	const owner = "11111111-1111-1111-1111-111111111111"
+	steps := []struct {
+		fixture string
+		want    want
+	}{
+		// a partial event doesn't move the watermark
+		{"checkout_session_completed.json", want{owner: owner, status: "incomplete"}},
+		{"customer_subscription_created.json", want{owner, "active", "price_pro", 1702592000, false, 1700000100}},
+		{"customer_subscription_updated.json", want{owner, "active", "price_team", 1705270400, true, 1700000300}},
+		// created before the last applied event, so it's skipped
+		{"customer_subscription_updated_stale.json", want{owner, "active", "price_team", 1705270400, true, 1700000300}},
+		{"invoice_payment_failed.json", want{owner, "past_due", "price_team", 1705270400, true, 1700000300}},
+		{"customer_subscription_deleted.json", want{owner, "canceled", "price_team", 1705270400, false, 1700000500}},
+	}
+
+	for _, step := range steps {
+		w := serve(router, signedStripeRequest(t, step.fixture))
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d: %s", step.fixture, w.Code, w.Body.String())
+		}
+
+		sub, err := appCtx.db.Subscription.Get(context.Background(), "sub_test_1")
+		if err != nil {
+			t.Fatalf("%s: %v", step.fixture, err)
+		}
+		got := want{
+			owner:             sub.Owner,
+			status:            sub.Status,
+			priceID:           sub.PriceID,
+			cancelAtPeriodEnd: sub.CancelAtPeriodEnd,
+		}
+		if sub.CurrentPeriodEnd != nil {
+			got.currentPeriodEnd = sub.CurrentPeriodEnd.Unix()
+		}
+		if sub.LastEventAt != nil {
+			got.lastEventAt = sub.LastEventAt.Unix()
+		}
+		if got != step.want {
+			t.Fatalf("%s: got %+v, want %+v", step.fixture, got, step.want)
+		}
+		if sub.BillingID != "cus_test_1" {
+			t.Fatalf("%s: got billing id %q", step.fixture, sub.BillingID)
+		}
+	}
+
+	count, err := appCtx.db.Subscription.Query().Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("got %d subscriptions, want 1", count)
+	}
+}
+
+func TestStripeWebhookPartialEventsOutOfOrder(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	router := newTestWebhookRouter(appCtx)
+
+	// the checkout is delivered before the earlier subscription event and a failed payment older than it after it
+	for _, fixture := range []string{"checkout_session_completed_late.json", "customer_subscription_created.json", "invoice_payment_failed_stale.json"} {
+		if w := serve(router, signedStripeRequest(t, fixture)); w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d: %s", fixture, w.Code, w.Body.String())
+		}
+	}
+	sub, err := appCtx.db.Subscription.Get(context.Background(), "sub_test_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Owner != "11111111-1111-1111-1111-111111111111" || sub.Status != "active" || sub.PriceID != "price_pro" {
+		t.Fatalf("got owner %q, status %q and price %q", sub.Owner, sub.Status, sub.PriceID)
+	}
+	if sub.LastEventAt == nil || sub.LastEventAt.Unix() != 1700000100 {
+		t.Fatalf("got watermark %v, want the subscription event's", sub.LastEventAt)
+	}
+}
+
+func TestStripeWebhookExpiresCachedPlansWithoutOwner(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	appCtx.prices = newPriceCache(time.Hour)
+	router := newTestWebhookRouter(appCtx)
+
+	const owner = "11111111-1111-1111-1111-111111111111"
+	appCtx.prices.set(owner, "price_old")
+	// without the checkout the subscription has no owner yet
+	serve(router, signedStripeRequest(t, "customer_subscription_created.json"))
+	if priceID, ok := appCtx.prices.get(owner); ok {
+		t.Fatalf("got cached price %q, want it expired", priceID)
+	}
+}
+
+func TestStripeWebhookActivePlan(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	router := newTestWebhookRouter(appCtx)
+
+	const owner = "11111111-1111-1111-1111-111111111111"
+	for _, fixture := range []string{"checkout_session_completed.json", "customer_subscription_created.json"} {
+		serve(router, signedStripeRequest(t, fixture))
+	}
+	priceID, err := currentPriceID(context.Background(), appCtx, owner, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if priceID != "price_pro" {
+		t.Fatalf("got price %q, want price_pro", priceID)
+	}
+
+	// the cached price is dropped once the subscription is canceled
+	serve(router, signedStripeRequest(t, "customer_subscription_deleted.json"))
+	priceID, err = currentPriceID(context.Background(), appCtx, owner, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if priceID != "" {
+		t.Fatalf("got price %q after the subscription was canceled", priceID)
+	}
+}
+
+func TestStripeWebhookRejectsInvalidSignature(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	router := newTestWebhookRouter(appCtx)
+
+	req := signedStripeRequest(t, "customer_subscription_created.json")
+	req.Header.Set("Stripe-Signature", fmt.Sprintf("t=%d,v1=%x", time.Now().Unix(), []byte("forged")))
+	if w := serve(router, req); w.Code != http.StatusBadRequest {
+		t.Fatalf("got %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	_, err := appCtx.db.Subscription.Get(context.Background(), "sub_test_1")
+	if !models.IsNotFound(err) {
+		t.Fatalf("got %v, want the subscription not to be stored", err)
+	}
+	audited, err := appCtx.db.AuditEvent.Query().Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if audited != 0 {
+		t.Fatalf("got %d audit events for a rejected request", audited)
+	}
+}
+
+func TestStripeWebhookDuplicateEvent(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	router := newTestWebhookRouter(appCtx)
+
+	for _, fixture := range []string{"checkout_session_completed.json", "customer_subscription_created.json", "customer_subscription_created.json"} {
+		if w := serve(router, signedStripeRequest(t, fixture)); w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d: %s", fixture, w.Code, w.Body.String())
+		}
+	}
+	events, err := appCtx.db.WebhookEvent.Query().
+		Where(webhookevent.Type("customer.subscription.created")).
+		All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Attempts != 1 || events[0].ProcessedAt == nil {
+		t.Fatalf("got %d events, want the event to be processed once", len(events))
+	}
+
+	// the redelivery isn't audited and the events are audited for the account of the stripe customer
+	audited, err := appCtx.db.AuditEvent.Query().All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(audited) != 2 {
+		t.Fatalf("got %d audit events, want one for each event", len(audited))
+	}
+	for _, a := range audited {
+		if a.Action != auditWebhookReceived || a.AccountID != "11111111-1111-1111-1111-111111111111" {
+			t.Fatalf("got audit event %s for account %q", a.Action, a.AccountID)
+		}
+	}
+	sub, err := appCtx.db.Subscription.Get(context.Background(), "sub_test_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Status != string(stripe.SubscriptionStatusActive) {
+		t.Fatalf("got status %s", sub.Status)
+	}
+}
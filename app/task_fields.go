@@ -0,0 +1,260 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/predicate"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+const (
+	maxTaskTags      = 20
+	maxTagNameLength = 50
+)
+
+// taskFieldsRequest holds the optional fields of a task which can be set on create and update. A nil field is left
+// unchanged, an empty due_at clears the due date, an empty tags list removes all the tags and an empty group_id
+// unassigns the task from its group.
+type taskFieldsRequest struct {
+	DueAt    *string  `json:"due_at,omitempty"`
+	Priority *string  `json:"priority,omitempty"`
+	Position *int     `json:"position,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	GroupID  *string  `json:"group_id,omitempty"`
+}
+
+func (req taskFieldsRequest) validate(v *validator) {
+	if req.DueAt != nil && *req.DueAt != "" {
+		_, err := time.Parse(time.RFC3339, *req.DueAt)
+		v.check(err == nil, "due_at", "must be a RFC3339 timestamp")
+	}
+	if req.Priority != nil {
+		v.taskPriority("priority", *req.Priority)
+	}
+	if req.Position != nil {
+		v.check(*req.Position >= 0, "position", "must not be negative")
+	}
+	v.check(len(req.Tags) <= maxTaskTags, "tags", fmt.Sprintf("must have at most %d items", maxTaskTags))
+	for _, name := range req.Tags {
+		v.required("tags", name)
+		v.maxLength("tags", name, maxTagNameLength)
+	}
+}
+
+// empty reports whether none of the fields is set.
+func (req taskFieldsRequest) empty() bool {
+	return req.DueAt == nil && req.Priority == nil && req.Position == nil && req.Tags == nil && req.GroupID == nil
+}
+
+// apply sets the fields on the mutation of a create or an update. tagIDs are the ids returned by ensureTags and the
+// group is checked by checkTaskGroup.
+func (req taskFieldsRequest) apply(m *models.TaskMutation, tagIDs []string) {
+	if req.DueAt != nil {
+		if *req.DueAt == "" {
+			m.ClearDueAt()
+		} else {
+			// validated by validate
+			dueAt, _ := time.Parse(time.RFC3339, *req.DueAt)
+			m.SetDueAt(dueAt)
+		}
+	}
+	if req.Priority != nil {
+		m.SetPriority(task.Priority(*req.Priority))
+	}
+	if req.Position != nil {
+		m.SetPosition(*req.Position)
+	}
+	if req.Tags != nil {
+		m.ClearTags()
+		m.AddTagIDs(tagIDs...)
+	}
+	if req.GroupID != nil {
+		if *req.GroupID == "" {
+			m.ClearGroup()
+		} else {
+			m.SetGroupID(*req.GroupID)
+		}
+	}
+}
+
+// checkTaskGroup returns a fieldErrors if the group the task is assigned to isn't a group of the owner's workspace.
+func checkTaskGroup(ctx context.Context, appCtx Context, owner string, groupID *string) error {
+	if groupID == nil || *groupID == "" {
+		return nil
+	}
+	exists, err := appCtx.db.Group.Query().
+		Where(group.ID(*groupID), group.HasWorkspaceWith(workspace.ID(owner))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fieldErrors{"group_id": "must be a group of the workspace"}
+	}
+	return nil
+}
+
+// ensureTags returns the ids of the owner's tags with the given names, creating the missing ones.
+func ensureTags(ctx context.Context, appCtx Context, owner string, names []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, nil
+	}
+
+	tags, err := appCtx.db.Tag.Query().Where(tag.Owner(owner), tag.NameIn(unique...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(unique))
+	existing := make(map[string]bool)
+	for _, t := range tags {
+		existing[t.Name] = true
+		ids = append(ids, t.ID)
+	}
+
+	for _, name := range unique {
+		if existing[name] {
+			continue
+		}
+		newTag, err := appCtx.db.Tag.Create().
+			SetID(shortuuid.New()).
+			SetOwner(owner).
+			SetName(name).
+			Save(ctx)
+		if models.IsConstraintError(err) {
+			// a concurrent request created the tag after the query
+			newTag, err = appCtx.db.Tag.Query().Where(tag.Owner(owner), tag.Name(name)).Only(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, newTag.ID)
+	}
+	return ids, nil
+}
+
+// withTx runs fn with a Context whose db is a transaction which is committed if fn succeeds. If appCtx is already in
+// a transaction, like the operations of a batch, fn runs in it.
+func withTx(ctx context.Context, appCtx Context, fn func(txCtx Context) error) error {
+	if appCtx.inTx {
+		return fn(appCtx)
+	}
+	tx, err := appCtx.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	txCtx := appCtx
+	txCtx.db = tx.Client()
+	txCtx.inTx = true
+	if err := fn(txCtx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// saveTaskWithTags is saveTask which also sets the tags with the names. The missing tags are created in the same
+// transaction, so a failed save doesn't leave them behind.
+func saveTaskWithTags(ctx context.Context, appCtx Context, t *models.Task, tagNames []string, apply func(update *models.TaskUpdate, tagIDs []string)) (*models.Task, error) {
+	var updated *models.Task
+	err := withTx(ctx, appCtx, func(txCtx Context) error {
+		tagIDs, err := ensureTags(ctx, txCtx, t.Owner, tagNames)
+		if err != nil {
+			return err
+		}
+		updated, err = saveTask(ctx, txCtx, t, func(update *models.TaskUpdate) {
+			apply(update, tagIDs)
+		})
+		return err
+	})
+	return updated, err
+}
+
+// newTask creates a task with the fields at the end of the owner's list unless a position is given. The task and its
+// missing tags are created in one transaction.
+func newTask(ctx context.Context, appCtx Context, owner, text string, fields taskFieldsRequest) (*models.Task, error) {
+	var created *models.Task
+	err := withTx(ctx, appCtx, func(txCtx Context) error {
+		var err error
+		created, err = createTask(ctx, txCtx, owner, text, fields)
+		return err
+	})
+	return created, err
+}
+
+func createTask(ctx context.Context, appCtx Context, owner, text string, fields taskFieldsRequest) (*models.Task, error) {
+	tagIDs, err := ensureTags(ctx, appCtx, owner, fields.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	create := appCtx.db.Task.Create().
+		SetID(shortuuid.New()).
+		SetStatus(task.StatusInprogress).
+		SetOwner(owner).
+		SetText(text)
+	if fields.Position == nil {
+		last, err := appCtx.db.Task.Query().
+			Where(task.Owner(owner)).
+			Order(models.Desc(task.FieldPosition)).
+			First(ctx)
+		if err != nil && !models.IsNotFound(err) {
+			return nil, err
+		}
+		if last != nil {
+			create.SetPosition(last.Position + 1)
+		}
+	}
+	fields.apply(create.Mutation(), tagIDs)
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return loadTask(ctx, appCtx, created.ID)
+}
+
+// loadTask returns the task along with its tags and group as rendered by newTaskResponse.
+func loadTask(ctx context.Context, appCtx Context, id string) (*models.Task, error) {
+	return appCtx.db.Task.Query().Where(task.ID(id)).WithTags().WithGroup().Only(ctx)
+}
+
+// taskOverdue matches the tasks which are past their due date and not done yet.
+func taskOverdue() predicate.Task {
+	return task.And(
+		task.DueAtLT(time.Now()),
+		task.Or(task.StatusNEQ(task.StatusDone), task.StatusIsNil()),
+	)
+}
+
+func isOverdue(t *models.Task) bool {
+	return t.DueAt != nil && t.DueAt.Before(time.Now()) && t.Status != task.StatusDone
+}
+
+// tagNames returns the sorted names of the loaded tags of the task.
+func tagNames(t *models.Task) []string {
+	names := make([]string, 0, len(t.Edges.Tags))
+	for _, tg := range t.Edges.Tags {
+		names = append(names, tg.Name)
+	}
+	sort.Strings(names)
+	return names
+}
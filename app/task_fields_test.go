@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
+)
+
+func TestEnsureTagsFindsAConcurrentlyCreatedTag(t *testing.T) {
+	appCtx := newTestContext(t)
+	ownerID, _ := newTestAccount(t, appCtx, "owner@example.com")
+
+	// a concurrent request creates the tag between the query and the create of ensureTags
+	var (
+		concurrent *models.Tag
+		raced      bool
+	)
+	appCtx.db.Tag.Use(func(next models.Mutator) models.Mutator {
+		return models.MutateFunc(func(ctx context.Context, m models.Mutation) (models.Value, error) {
+			mutation, ok := m.(*models.TagMutation)
+			if ok && !raced && m.Op().Is(models.OpCreate) {
+				raced = true
+				name, _ := mutation.Name()
+				var err error
+				concurrent, err = mutation.Client().Tag.Create().
+					SetID(shortuuid.New()).
+					SetOwner(ownerID).
+					SetName(name).
+					Save(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	created, err := newTask(context.Background(), appCtx, ownerID, "tagged", taskFieldsRequest{Tags: []string{"home"}})
+	if err != nil {
+		t.Fatalf("got %v, want the task with the existing tag", err)
+	}
+	tags, err := appCtx.db.Task.QueryTags(created).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].ID != concurrent.ID {
+		t.Fatalf("got tags %v, want the concurrently created tag %s", tags, concurrent.ID)
+	}
+	if n := appCtx.db.Tag.Query().Where(tag.Owner(ownerID)).CountX(context.Background()); n != 1 {
+		t.Fatalf("got %d tags, want 1", n)
+	}
+}
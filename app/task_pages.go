@@ -1,111 +1,266 @@
 package app
 
 import (
+	"bytes"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/adnaan/authn"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
 	"github.com/adnaan/gomodest-starter/app/gen/models/task"
 	rl "github.com/adnaan/renderlayout"
 	"github.com/go-chi/chi"
-	"github.com/lithammer/shortuuid/v3"
 )
 
+const taskFormDateLayout = "2006-01-02"
+
+// listTasks lists the tasks in their manual order, optionally filtered by the tag, group_id and overdue query params.
 func listTasks(appCtx Context) rl.Data {
 	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
-		userID := authn.AccountIDFromContext(r)
-		tasks, err := appCtx.db.Task.Query().Where(task.Owner(userID)).All(r.Context())
+		workspaceID := workspaceIDFromContext(r)
+		query := appCtx.db.Task.Query().Where(task.Owner(workspaceID))
+
+		tagName := r.URL.Query().Get("tag")
+		if tagName != "" {
+			query = query.Where(task.HasTagsWith(tag.Owner(workspaceID), tag.Name(tagName)))
+		}
+		groupID := r.URL.Query().Get("group_id")
+		if groupID != "" {
+			query = query.Where(task.HasGroupWith(group.ID(groupID)))
+		}
+		overdue := r.URL.Query().Get("overdue") == "true"
+		if overdue {
+			query = query.Where(taskOverdue())
+		}
+
+		tasks, err := query.
+			WithTags().
+			WithGroup().
+			Order(models.Asc(task.FieldPosition, task.FieldCreatedAt)).
+			All(r.Context())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		canWrite, err := hasPermission(r.Context(), appCtx, authn.AccountIDFromContext(r), workspaceID, permTasksWrite)
 		if err != nil {
 			return nil, fmt.Errorf("%w", err)
 		}
 
 		return rl.D{
-			"tasks": tasks,
+			"tasks":           newTaskResponses(tasks),
+			"tag":             tagName,
+			"overdue":         overdue,
+			"group_id":        groupID,
+			"can_write_tasks": canWrite,
 		}, nil
 	}
 }
 
-func createNewTask(appCtx Context) rl.Data {
+// taskFormFields converts the due date, priority and comma separated tags of a task form. An empty due date clears
+// it and empty tags remove all the tags.
+func taskFormFields(v *validator, dueDate, priority, tags string) taskFieldsRequest {
+	var fields taskFieldsRequest
+
+	dueAt := ""
+	if dueDate != "" {
+		date, err := time.Parse(taskFormDateLayout, dueDate)
+		v.check(err == nil, "due_at", "must be a date")
+		// a task is due by the end of the day
+		dueAt = date.Add(24*time.Hour - time.Second).Format(time.RFC3339)
+	}
+	fields.DueAt = &dueAt
+
+	if priority != "" {
+		fields.Priority = &priority
+	}
+
+	fields.Tags = []string{}
+	for _, name := range strings.Split(tags, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			fields.Tags = append(fields.Tags, name)
+		}
+	}
+
+	fields.validate(v)
+	return fields
+}
+
+// taskFormAction handles a form submission of the app page. It returns the event of the changed task or nil if no
+// task was changed.
+type taskFormAction func(w http.ResponseWriter, r *http.Request) (*taskEvent, rl.D, error)
+
+// taskForm responds to turbo with the stream fragment of the changed task instead of the whole app page. The app page
+// is still rendered for other clients and for submissions which failed or didn't change a task.
+func taskForm(appCtx Context, index rl.Render, action taskFormAction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		event, data, err := action(w, r)
+		if event != nil && err == nil && acceptsTurboStream(r) {
+			var buf bytes.Buffer
+			renderErr := renderTaskFormStream(&buf, appCtx, *event)
+			if renderErr == nil {
+				w.Header().Set("Content-Type", turboStreamContentType+"; charset=utf-8")
+				_, _ = w.Write(buf.Bytes())
+				return
+			}
+			log.Printf("taskForm: rendering %s event %s: %v\n", event.Type, event.ID, renderErr)
+		}
+
+		result := func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+			return data, err
+		}
+		index("app", result, listTasks(appCtx))(w, r)
+	}
+}
+
+func createNewTask(appCtx Context) taskFormAction {
 	type req struct {
-		Text string `json:"text"`
+		Text     string `json:"text"`
+		DueDate  string
+		Priority string
+		Tags     string
 	}
 
-	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+	return func(w http.ResponseWriter, r *http.Request) (*taskEvent, rl.D, error) {
+		if err := checkPermission(r, appCtx, permTasksWrite); err != nil {
+			return nil, nil, err
+		}
+
 		req := new(req)
 		err := r.ParseForm()
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return nil, nil, fmt.Errorf("%w", err)
 		}
 
 		err = appCtx.formDecoder.Decode(req, r.Form)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return nil, nil, fmt.Errorf("%w", err)
 		}
 
-		if req.Text == "" {
-			return nil, fmt.Errorf("%w", fmt.Errorf("empty task"))
+		v := new(validator)
+		v.taskText("text", req.Text)
+		fields := taskFormFields(v, req.DueDate, req.Priority, req.Tags)
+		if err := v.err(); err != nil {
+			data, err := formErrors(err)
+			return nil, data, err
 		}
 
-		userID := authn.AccountIDFromContext(r)
-		_, err = appCtx.db.Task.Create().
-			SetID(shortuuid.New()).
-			SetStatus(task.StatusInprogress).
-			SetOwner(userID).
-			SetText(req.Text).
-			Save(r.Context())
+		workspaceID := workspaceIDFromContext(r)
+		createdTask, err := newTaskWithinLimit(r.Context(), appCtx, workspaceID, req.Text, fields)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return nil, nil, fmt.Errorf("%w", err)
 		}
+		event := emitTaskEvent(r.Context(), appCtx, workspaceID, taskCreatedEvent, newTaskResponse(createdTask))
 
-		return nil, nil
+		return &event, nil, nil
 	}
 }
 
-func deleteTask(appCtx Context) rl.Data {
-	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+func deleteTask(appCtx Context) taskFormAction {
+	type req struct {
+		Version int
+	}
+	return func(w http.ResponseWriter, r *http.Request) (*taskEvent, rl.D, error) {
+		if err := checkPermission(r, appCtx, permTasksWrite); err != nil {
+			return nil, nil, err
+		}
+
+		req := new(req)
+		err := r.ParseForm()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+
+		err = appCtx.formDecoder.Decode(req, r.Form)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+
 		id := chi.URLParam(r, "id")
-		userID := authn.AccountIDFromContext(r)
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), appCtx, workspaceID, id)
+		if err != nil {
+			if models.IsNotFound(err) {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+
+		if !versionMatches(req.Version, ownedTask) {
+			return nil, nil, fmt.Errorf("%w", errTaskChanged)
+		}
 
-		_, err := appCtx.db.Task.Delete().Where(task.And(
-			task.Owner(userID), task.ID(id),
-		)).Exec(r.Context())
+		err = removeTask(r.Context(), appCtx, ownedTask)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return nil, nil, fmt.Errorf("%w", err)
 		}
+		event := emitTaskEvent(r.Context(), appCtx, workspaceID, taskDeletedEvent, map[string]string{"id": id})
 
-		return nil, nil
+		return &event, nil, nil
 	}
 }
 
-func editTask(appCtx Context) rl.Data {
+func editTask(appCtx Context) taskFormAction {
 	type req struct {
-		Text string `json:"text"`
+		Text     string `json:"text"`
+		DueDate  string
+		Priority string
+		Tags     string
+		Version  int
 	}
-	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+	return func(w http.ResponseWriter, r *http.Request) (*taskEvent, rl.D, error) {
+		if err := checkPermission(r, appCtx, permTasksWrite); err != nil {
+			return nil, nil, err
+		}
+
 		req := new(req)
 		err := r.ParseForm()
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return nil, nil, fmt.Errorf("%w", err)
 		}
 
 		err = appCtx.formDecoder.Decode(req, r.Form)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return nil, nil, fmt.Errorf("%w", err)
 		}
 
-		if req.Text == "" {
-			return nil, fmt.Errorf("%w", fmt.Errorf("empty task"))
+		v := new(validator)
+		v.taskText("text", req.Text)
+		fields := taskFormFields(v, req.DueDate, req.Priority, req.Tags)
+		if err := v.err(); err != nil {
+			data, err := formErrors(err)
+			return nil, data, err
 		}
 
 		id := chi.URLParam(r, "id")
-		userID := authn.AccountIDFromContext(r)
-		err = appCtx.db.Task.Update().Where(task.And(
-			task.Owner(userID), task.ID(id),
-		)).SetText(req.Text).Exec(r.Context())
+		workspaceID := workspaceIDFromContext(r)
+		ownedTask, err := findOwnedTask(r.Context(), appCtx, workspaceID, id)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			if models.IsNotFound(err) {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+
+		if !versionMatches(req.Version, ownedTask) {
+			return nil, nil, fmt.Errorf("%w", errTaskChanged)
+		}
+
+		updatedTask, err := saveTaskWithTags(r.Context(), appCtx, ownedTask, fields.Tags, func(update *models.TaskUpdate, tagIDs []string) {
+			update.SetText(req.Text)
+			fields.apply(update.Mutation(), tagIDs)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
 		}
+		event := emitTaskEvent(r.Context(), appCtx, workspaceID, taskUpdatedEvent, newTaskResponse(updatedTask))
 
-		return nil, nil
+		return &event, nil, nil
 	}
 }
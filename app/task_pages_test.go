@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	rl "github.com/adnaan/renderlayout"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+// withTaskStreamTemplates parses the stream templates from the templates directory at the root of the repository.
+func withTaskStreamTemplates(t *testing.T, appCtx Context) Context {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(".."); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	appCtx.taskStreams, err = parseTaskStreamTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return appCtx
+}
+
+func TestTaskFormTurboStream(t *testing.T) {
+	appCtx := withTaskStreamTemplates(t, newTestContext(t))
+	accountID, _ := newTestAccount(t, appCtx, "owner@example.com")
+
+	// the app page rendered by the fallback is replaced by its view name
+	var pages int
+	index := func(view string, dataFuncs ...rl.Data) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			pages++
+			_, _ = w.Write([]byte("page " + view))
+		}
+	}
+	submit := func(action taskFormAction, path, id string, form url.Values, turbo bool) (string, string) {
+		req := newTestWorkspaceRequest(t, appCtx, accountID, http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if turbo {
+			req.Header.Set("Accept", turboStreamContentType+", text/html, application/xhtml+xml")
+		}
+		if id != "" {
+			req = withURLParam(req, "id", id)
+		}
+		w := serve(taskForm(appCtx, index, action), req)
+		return w.Header().Get("Content-Type"), w.Body.String()
+	}
+	version := func(id string) string {
+		return strconv.Itoa(appCtx.db.Task.GetX(context.Background(), id).Version)
+	}
+
+	contentType, body := submit(createNewTask(appCtx), "/app/tasks/new", "", url.Values{"Text": {"streamed task"}}, true)
+	if !strings.HasPrefix(contentType, turboStreamContentType) {
+		t.Fatalf("got content type %q, want a turbo stream", contentType)
+	}
+	created := appCtx.db.Task.Query().Where(task.Text("streamed task")).OnlyX(context.Background())
+	for _, want := range []string{`action="append" target="tasks"`, `id="task-` + created.ID + `"`, "streamed task",
+		`action="replace" target="task-form"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("create: got %s, want %s", body, want)
+		}
+	}
+
+	_, body = submit(editTask(appCtx), "/app/tasks/"+created.ID+"/edit", created.ID,
+		url.Values{"Text": {"edited task"}, "Version": {version(created.ID)}}, true)
+	for _, want := range []string{`action="replace" target="task-` + created.ID + `"`, "edited task"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("edit: got %s, want %s", body, want)
+		}
+	}
+
+	_, body = submit(deleteTask(appCtx), "/app/tasks/"+created.ID+"/delete", created.ID,
+		url.Values{"Version": {version(created.ID)}}, true)
+	if !strings.Contains(body, `action="remove" target="task-`+created.ID+`"`) {
+		t.Errorf("delete: got %s, want the task removed", body)
+	}
+	if pages != 0 {
+		t.Fatalf("got %d pages rendered for turbo, want none", pages)
+	}
+
+	// the app page is rendered for the other clients and for a failed submission
+	if _, body = submit(createNewTask(appCtx), "/app/tasks/new", "", url.Values{"Text": {"page task"}}, false); body != "page app" {
+		t.Errorf("got %s, want the app page without turbo", body)
+	}
+	if _, body = submit(createNewTask(appCtx), "/app/tasks/new", "", url.Values{"Text": {""}}, true); body != "page app" {
+		t.Errorf("got %s, want the app page with the errors of an invalid task", body)
+	}
+	if pages != 2 {
+		t.Fatalf("got %d pages rendered, want 2", pages)
+	}
+}
@@ -0,0 +1,359 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/go-chi/valve"
+)
+
+const (
+	streamHeartbeatInterval = 15 * time.Second
+	// the clients reconnect after this delay and resume after the last event they got with Last-Event-ID
+	streamRetryMillis = 1000
+	// events are dropped for a subscriber which doesn't keep up
+	streamBufferSize = 32
+	// the number of the latest events of an owner which are replayed to a reconnecting stream
+	streamReplaySize = 100
+	// the latest events of an owner without streams are dropped once it had no events for this long
+	streamReplayTTL = 5 * time.Minute
+
+	turboStreamContentType = "text/vnd.turbo-stream.html"
+)
+
+// taskHub fans out the task events of an account to its open event streams.
+type taskHub struct {
+	mu          sync.Mutex
+	subscribers map[string][]chan taskEvent
+	// the latest events of every owner, oldest first, and when the last one was published
+	recent    map[string][]taskEvent
+	recentAt  map[string]time.Time
+	lastSweep time.Time
+	lever     valve.LeverControl
+	stopped   bool
+}
+
+// newTaskHub returns a hub which closes all the streams once ctx is done or its valve is shut off.
+func newTaskHub(ctx context.Context) *taskHub {
+	h := &taskHub{
+		subscribers: make(map[string][]chan taskEvent),
+		recent:      make(map[string][]taskEvent),
+		recentAt:    make(map[string]time.Time),
+	}
+	stop := ctx.Done()
+	if lever, ok := ctx.Value(valve.ValveCtxKey).(valve.LeverControl); ok {
+		h.lever = lever
+		stop = lever.Stop()
+	}
+
+	go func() {
+		<-stop
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.stopped = true
+		for owner, subscribers := range h.subscribers {
+			for _, ch := range subscribers {
+				close(ch)
+			}
+			h.subscribers[owner] = nil
+		}
+	}()
+
+	return h
+}
+
+// subscribe returns the events of the owner until unsubscribe is called or the hub is stopped. If lastEventID is one of
+// the latest events of the owner, the events after it are returned to be sent first. Otherwise the missed events, if
+// any, are lost.
+func (h *taskHub) subscribe(owner, lastEventID string) ([]taskEvent, <-chan taskEvent, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan taskEvent, streamBufferSize)
+	if h.stopped {
+		close(ch)
+		return nil, ch, func() {}
+	}
+	h.subscribers[owner] = append(h.subscribers[owner], ch)
+	h.sweep(time.Now())
+
+	var missed []taskEvent
+	if lastEventID != "" {
+		recent := h.recent[owner]
+		for i := range recent {
+			if recent[i].ID == lastEventID {
+				missed = append(missed, recent[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return missed, ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		var subscribers []chan taskEvent
+		for _, sub := range h.subscribers[owner] {
+			if sub == ch {
+				close(ch)
+				continue
+			}
+			subscribers = append(subscribers, sub)
+		}
+		h.subscribers[owner] = subscribers
+	}
+}
+
+func (h *taskHub) publish(owner string, event taskEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	recent := append(h.recent[owner], event)
+	if len(recent) > streamReplaySize {
+		recent = recent[len(recent)-streamReplaySize:]
+	}
+	now := time.Now()
+	h.recent[owner] = recent
+	h.recentAt[owner] = now
+	h.sweep(now)
+
+	for _, ch := range h.subscribers[owner] {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("taskHub: dropping %s event %s for a slow stream of %s\n", event.Type, event.ID, owner)
+		}
+	}
+}
+
+// sweep drops the latest events of the owners which have no streams and had no events for streamReplayTTL, so the
+// hub doesn't keep the events of every workspace ever seen. It runs at most once per streamReplayTTL and expects h.mu
+// to be held.
+func (h *taskHub) sweep(now time.Time) {
+	if now.Sub(h.lastSweep) < streamReplayTTL {
+		return
+	}
+	h.lastSweep = now
+	// the builtin delete is shadowed by the api handler
+	recent := make(map[string][]taskEvent, len(h.recent))
+	recentAt := make(map[string]time.Time, len(h.recentAt))
+	for owner, at := range h.recentAt {
+		if len(h.subscribers[owner]) > 0 || now.Sub(at) < streamReplayTTL {
+			recent[owner] = h.recent[owner]
+			recentAt[owner] = at
+		}
+	}
+	h.recent, h.recentAt = recent, recentAt
+	subscribers := make(map[string][]chan taskEvent, len(h.subscribers))
+	for owner, chs := range h.subscribers {
+		if len(chs) > 0 {
+			subscribers[owner] = chs
+		}
+	}
+	h.subscribers = subscribers
+}
+
+// parseTaskStreamTemplates parses the partials rendered into turbo stream fragments from the default
+// renderlayout templates directory.
+func parseTaskStreamTemplates() (*template.Template, error) {
+	return template.ParseFiles(
+		filepath.Join("templates", "partials", "errors.html"),
+		filepath.Join("templates", "partials", "task.html"),
+		filepath.Join("templates", "partials", "task_form.html"),
+		filepath.Join("templates", "partials", "task_stream.html"),
+	)
+}
+
+// renderTaskStream writes the turbo stream fragment which applies the event to the task list of app.html.
+func renderTaskStream(w io.Writer, appCtx Context, event taskEvent) error {
+	return appCtx.taskStreams.ExecuteTemplate(w, "task_stream", newTaskStream(event))
+}
+
+// renderTaskFormStream is the renderTaskStream of a form submission which also resets the new task form and clears
+// the errors of a previous submission.
+func renderTaskFormStream(w io.Writer, appCtx Context, event taskEvent) error {
+	if err := renderTaskStream(w, appCtx, event); err != nil {
+		return err
+	}
+	return appCtx.taskStreams.ExecuteTemplate(w, "task_form_stream", nil)
+}
+
+// acceptsTurboStream reports whether the request was made by turbo which accepts stream fragments as the response.
+func acceptsTurboStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), turboStreamContentType)
+}
+
+// streamTasks streams the task changes as turbo stream fragments.
+func streamTasks(appCtx Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serveTaskEvents(w, r, appCtx, func(event taskEvent) (string, string, error) {
+			var buf bytes.Buffer
+			if err := renderTaskStream(&buf, appCtx, event); err != nil {
+				return "", "", err
+			}
+			// turbo only handles unnamed events
+			return "", buf.String(), nil
+		})
+	}
+}
+
+// streamTaskEvents streams the task changes as server sent events named after the event type with the same json
+// payload as the webhooks.
+func streamTaskEvents(appCtx Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serveTaskEvents(w, r, appCtx, func(event taskEvent) (string, string, error) {
+			data, err := json.Marshal(event)
+			if err != nil {
+				return "", "", err
+			}
+			return event.Type, string(data), nil
+		})
+	}
+}
+
+type taskStream struct {
+	Action string
+	Target string
+	Task   *taskResponse
+}
+
+func newTaskStream(event taskEvent) taskStream {
+	var stream taskStream
+	switch data := event.Data.(type) {
+	case *taskResponse:
+		stream.Target = "task-" + data.ID
+		stream.Task = data
+	case map[string]string:
+		stream.Target = "task-" + data["id"]
+	}
+
+	switch event.Type {
+	case taskCreatedEvent:
+		stream.Action = "append"
+	case taskUpdatedEvent:
+		stream.Action = "replace"
+	default:
+		stream.Action = "remove"
+	}
+	return stream
+}
+
+// serveTaskEvents writes the events of the account as server sent events until the client goes away, a write fails
+// or the hub is stopped. format returns the optional event name and the data of an event. Every event carries its id,
+// a client which reconnects with it in the Last-Event-ID header gets the events it missed in between.
+func serveTaskEvents(w http.ResponseWriter, r *http.Request, appCtx Context, format func(event taskEvent) (string, string, error)) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		render.Render(w, r, ErrInternal(fmt.Errorf("streaming is not supported")))
+		return
+	}
+
+	// keeps the valve open until the stream is closed so that a shutdown waits for it
+	if appCtx.hub.lever != nil {
+		if err := appCtx.hub.lever.Open(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		defer appCtx.hub.lever.Close()
+	}
+
+	// the stream outlives the server's write timeout, without a deadline a dead client is noticed by a failed write
+	if err := clearWriteDeadline(w); err != nil {
+		log.Printf("serveTaskEvents: clearing the write deadline: %v\n", err)
+	}
+
+	missed, events, unsubscribe := appCtx.hub.subscribe(workspaceIDFromContext(r), r.Header.Get("Last-Event-ID"))
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(event taskEvent) error {
+		name, data, err := format(event)
+		if err != nil {
+			log.Printf("serveTaskEvents: formatting %s event %s: %v\n", event.Type, event.ID, err)
+			return nil
+		}
+		return writeTaskEvent(w, event.ID, name, data)
+	}
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", streamRetryMillis); err != nil {
+		return
+	}
+	for _, event := range missed {
+		if err := send(event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			_, err = io.WriteString(w, ": heartbeat\n\n")
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			err = send(event)
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// clearWriteDeadline removes the write deadline of the connection like http.ResponseController does on newer go
+// versions, unwrapping the middleware response writers which support it.
+func clearWriteDeadline(w http.ResponseWriter) error {
+	type deadlineSetter interface {
+		SetWriteDeadline(deadline time.Time) error
+	}
+	type unwrapper interface {
+		Unwrap() http.ResponseWriter
+	}
+	for {
+		switch rw := w.(type) {
+		case deadlineSetter:
+			return rw.SetWriteDeadline(time.Time{})
+		case unwrapper:
+			w = rw.Unwrap()
+		default:
+			return fmt.Errorf("the response writer %T doesn't support deadlines", w)
+		}
+	}
+}
+
+// writeTaskEvent writes a server sent event with the id, the optional name and the data.
+func writeTaskEvent(w io.Writer, id, name, data string) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %s\n", id)
+	if name != "" {
+		fmt.Fprintf(&buf, "event: %s\n", name)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(data), "\n") {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTaskHubReplay(t *testing.T) {
+	hub := newTaskHub(context.Background())
+	for _, id := range []string{"evt_1", "evt_2", "evt_3"} {
+		hub.publish("owner", taskEvent{ID: id, Type: taskCreatedEvent})
+	}
+
+	for lastEventID, want := range map[string][]string{
+		"":      nil,
+		"evt_1": {"evt_2", "evt_3"},
+		"evt_3": nil,
+		// unknown or too old to be replayed
+		"evt_0": nil,
+	} {
+		missed, _, unsubscribe := hub.subscribe("owner", lastEventID)
+		unsubscribe()
+		var got []string
+		for _, event := range missed {
+			got = append(got, event.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("%q: got %v, want %v", lastEventID, got, want)
+		}
+	}
+
+	// the events of another owner aren't replayed
+	if missed, _, unsubscribe := hub.subscribe("other", "evt_1"); len(missed) != 0 {
+		t.Errorf("got %d events of another owner", len(missed))
+	} else {
+		unsubscribe()
+	}
+
+	for i := 0; i < streamReplaySize+10; i++ {
+		hub.publish("owner", taskEvent{ID: "evt_new", Type: taskCreatedEvent})
+	}
+	if got := len(hub.recent["owner"]); got != streamReplaySize {
+		t.Errorf("got %d recent events, want %d", got, streamReplaySize)
+	}
+}
+
+func TestTaskHubDropsIdleOwners(t *testing.T) {
+	hub := newTaskHub(context.Background())
+	hub.publish("idle", taskEvent{ID: "evt_1", Type: taskCreatedEvent})
+	hub.publish("streaming", taskEvent{ID: "evt_2", Type: taskCreatedEvent})
+	_, _, unsubscribe := hub.subscribe("streaming", "")
+	defer unsubscribe()
+
+	hub.mu.Lock()
+	past := time.Now().Add(-2 * streamReplayTTL)
+	hub.recentAt["idle"], hub.recentAt["streaming"], hub.lastSweep = past, past, past
+	hub.mu.Unlock()
+	hub.publish("active", taskEvent{ID: "evt_3", Type: taskCreatedEvent})
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for owner, kept := range map[string]bool{"idle": false, "streaming": true, "active": true} {
+		if _, ok := hub.recent[owner]; ok != kept {
+			t.Errorf("%s: got kept %v, want %v", owner, ok, kept)
+		}
+	}
+}
+
+func TestClearWriteDeadline(t *testing.T) {
+	var cleared bool
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cleared = clearWriteDeadline(w) == nil
+	}))
+	server.Config.WriteTimeout = time.Second
+	server.Start()
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if !cleared {
+		t.Fatal("the write deadline of the server's response writer wasn't cleared")
+	}
+}
+
+func TestStreamTaskEvents(t *testing.T) {
+	// the stream isn't metered, it's served once the quota is used up
+	appCtx := withAPICallsQuota(newTestContext(t), 1)
+	accountID, token := newTestAccount(t, appCtx, "owner@example.com")
+	server := httptest.NewServer(newTestAPI(t, appCtx))
+	t.Cleanup(server.Close)
+	for i, want := range []int{http.StatusOK, http.StatusForbidden} {
+		if w := apiRequest(t, newTestAPI(t, appCtx), token, http.MethodGet, "/tasks", nil, nil); w.Code != want {
+			t.Fatalf("call %d: got %d, want %d", i, w.Code, want)
+		}
+	}
+
+	appCtx.hub.publish(accountID, taskEvent{ID: "evt_1", Type: taskCreatedEvent, Data: map[string]string{"id": "1"}})
+	appCtx.hub.publish(accountID, taskEvent{ID: "evt_2", Type: taskDeletedEvent, Data: map[string]string{"id": "1"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/tasks/events", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Last-Event-ID", "evt_1")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("got %d, want %d", res.StatusCode, http.StatusOK)
+	}
+
+	// the missed event is replayed first, then the new ones follow
+	var lines []string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "retry:") {
+			continue
+		}
+		lines = append(lines, line)
+		if line == "id: evt_2" {
+			appCtx.hub.publish(accountID, taskEvent{ID: "evt_3", Type: taskCreatedEvent, Data: map[string]string{"id": "2"}})
+		}
+		if strings.HasPrefix(line, "data:") && strings.Contains(line, "evt_3") {
+			break
+		}
+	}
+	got := strings.Join(lines, "\n")
+	want := strings.Join([]string{
+		"id: evt_2",
+		"event: " + taskDeletedEvent,
+		`data: {"id":"evt_2","type":"` + taskDeletedEvent + `","created_at":"0001-01-01T00:00:00Z","data":{"id":"1"}}`,
+		"id: evt_3",
+		"event: " + taskCreatedEvent,
+		`data: {"id":"evt_3","type":"` + taskCreatedEvent + `","created_at":"0001-01-01T00:00:00Z","data":{"id":"2"}}`,
+	}, "\n")
+	if got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+var errTaskChanged = errors.New("the task was changed in the meantime, reload it and try again")
+
+// taskETag is derived from the version of the task which is incremented by every change.
+func taskETag(t *models.Task) string {
+	return fmt.Sprintf(`"%d"`, t.Version)
+}
+
+// ifMatch reports whether the If-Match header allows changing the task. An empty header or * matches any version.
+// If-Match uses the strong comparison (RFC 9110 13.1.1), so a weak W/ tag never matches.
+func ifMatch(header string, t *models.Task) bool {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimSpace(tag) == taskETag(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// versionMatches is the ifMatch of the html forms which post the version they were rendered with. A zero version
+// matches any version.
+func versionMatches(version int, t *models.Task) bool {
+	return version == 0 || version == t.Version
+}
+
+// saveTask applies the update only if the task is still at the version it was read with.
+// errTaskChanged is returned if it was changed in between.
+func saveTask(ctx context.Context, appCtx Context, t *models.Task, apply func(update *models.TaskUpdate)) (*models.Task, error) {
+	update := appCtx.db.Task.Update().
+		Where(task.ID(t.ID), task.Owner(t.Owner), task.Version(t.Version)).
+		AddVersion(1).
+		SetUpdatedAt(time.Now())
+	apply(update)
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, errTaskChanged
+	}
+	return loadTask(ctx, appCtx, t.ID)
+}
+
+// removeTask deletes the task only if it's still at the version it was read with.
+// errTaskChanged is returned if it was changed in between.
+func removeTask(ctx context.Context, appCtx Context, t *models.Task) error {
+	deleted, err := appCtx.db.Task.Delete().
+		Where(task.ID(t.ID), task.Owner(t.Owner), task.Version(t.Version)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errTaskChanged
+	}
+	return nil
+}
+
+// renderTask responds with the task and its ETag.
+func renderTask(w http.ResponseWriter, r *http.Request, t *models.Task) {
+	w.Header().Set("ETag", taskETag(t))
+	render.Render(w, r, newTaskResponse(t))
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
+)
+
+func TestIfMatch(t *testing.T) {
+	tk := &models.Task{Version: 3}
+	for header, want := range map[string]bool{
+		"":             true,
+		"*":            true,
+		`"3"`:          true,
+		` "2", "3" `:   true,
+		`"2"`:          false,
+		`W/"3"`:        false,
+		`W/"2", W/"3"`: false,
+		`W/"3", "4"`:   false,
+	} {
+		if got := ifMatch(header, tk); got != want {
+			t.Errorf("%q: got %v, want %v", header, got, want)
+		}
+	}
+}
+
+func TestSaveTaskWithTagsRollsBackTags(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+	stale, err := newTask(ctx, appCtx, "owner", "tagged", taskFieldsRequest{Tags: []string{"kept"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// changed in between
+	if _, err := saveTask(ctx, appCtx, stale, func(update *models.TaskUpdate) { update.SetText("changed") }); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = saveTaskWithTags(ctx, appCtx, stale, []string{"kept", "new"}, func(update *models.TaskUpdate, tagIDs []string) {
+		update.ClearTags().AddTagIDs(tagIDs...)
+	})
+	if !errors.Is(err, errTaskChanged) {
+		t.Fatalf("got %v, want %v", err, errTaskChanged)
+	}
+	tags, err := appCtx.db.Tag.Query().Where(tag.Owner("owner")).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].Name != "kept" {
+		t.Fatalf("got %d tags, want the new tag to be rolled back", len(tags))
+	}
+}
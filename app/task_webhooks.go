@@ -0,0 +1,214 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+)
+
+// task events sent to the webhook endpoints of the task owner
+const (
+	taskCreatedEvent = "task.created"
+	taskUpdatedEvent = "task.updated"
+	taskDeletedEvent = "task.deleted"
+)
+
+// taskEvent is sent to the webhook endpoints and the event streams of the task owner. Data is the *taskResponse of
+// the task or its id for deleted tasks.
+type taskEvent struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// emitTaskEvent publishes the event to the open event streams of the owner, queues it for every webhook endpoint of
+// the owner and attempts the deliveries in the background. Failures are logged and never fail the task mutation itself.
+// The event is returned to render it for the client which made the change.
+func emitTaskEvent(ctx context.Context, appCtx Context, owner, eventType string, data interface{}) taskEvent {
+	event := taskEvent{
+		ID:        shortuuid.New(),
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		Data:      data,
+	}
+	if appCtx.hub != nil {
+		appCtx.hub.publish(owner, event)
+	}
+
+	endpoints, err := appCtx.db.WebhookEndpoint.Query().Where(webhookendpoint.Owner(owner)).All(ctx)
+	if err != nil {
+		log.Printf("emitTaskEvent %s: querying endpoints: %v\n", eventType, err)
+		return event
+	}
+	if len(endpoints) == 0 {
+		return event
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("emitTaskEvent %s: %v\n", eventType, err)
+		return event
+	}
+
+	for _, endpoint := range endpoints {
+		// scheduled as a retry in case the immediate attempt below never completes
+		delivery, err := appCtx.db.WebhookDelivery.Create().
+			SetEndpointID(endpoint.ID).
+			SetEventID(event.ID).
+			SetEventType(eventType).
+			SetPayload(payload).
+			SetNextAttemptAt(time.Now().Add(webhookRetryBackoff(appCtx.cfg, 1))).
+			Save(ctx)
+		if err != nil {
+			log.Printf("emitTaskEvent %s: creating delivery: %v\n", eventType, err)
+			continue
+		}
+
+		go func(endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) {
+			err := deliverWebhook(context.Background(), appCtx, endpoint, delivery)
+			if err != nil {
+				log.Printf("deliverWebhook %s to %s: %v\n", delivery.EventID, endpoint.URL, err)
+			}
+		}(endpoint, delivery)
+	}
+
+	return event
+}
+
+// claimWebhookDelivery marks the delivery as being attempted unless another goroutine or instance claimed it or
+// attempted it since it was read. Only the claimer posts the delivery, so it isn't sent twice.
+func claimWebhookDelivery(ctx context.Context, db *models.Client, delivery *models.WebhookDelivery) (bool, error) {
+	now := time.Now()
+	claimed, err := db.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.ID(delivery.ID),
+			webhookdelivery.Attempts(delivery.Attempts),
+			webhookdelivery.DeliveredAtIsNil(),
+			webhookdelivery.Or(webhookdelivery.ClaimedAtIsNil(), webhookdelivery.ClaimedAtLT(now.Add(-webhookClaimLease))),
+		).
+		SetClaimedAt(now).
+		Save(ctx)
+	return claimed > 0, err
+}
+
+// deliverWebhook posts the delivery payload signed with the endpoint secret and records the outcome.
+// A failed delivery is scheduled to be retried with an exponential backoff until the configured number of attempts.
+// A delivery claimed by someone else is skipped.
+func deliverWebhook(ctx context.Context, appCtx Context, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) error {
+	claimed, err := claimWebhookDelivery(ctx, appCtx.db, delivery)
+	if err != nil {
+		return fmt.Errorf("claiming webhook delivery %d: %v", delivery.ID, err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	statusCode, deliveryErr := postWebhook(ctx, appCtx, endpoint, delivery)
+	attempts := delivery.Attempts + 1
+
+	update := delivery.Update().SetAttempts(attempts).ClearClaimedAt()
+	if statusCode != 0 {
+		update.SetStatusCode(statusCode)
+	}
+	if deliveryErr == nil {
+		update.SetDeliveredAt(time.Now()).
+			ClearError().
+			ClearNextAttemptAt()
+	} else {
+		update.SetError(deliveryErr.Error())
+		if attempts < appCtx.cfg.WebhookMaxAttempts {
+			update.SetNextAttemptAt(time.Now().Add(webhookRetryBackoff(appCtx.cfg, attempts)))
+		} else {
+			update.ClearNextAttemptAt()
+		}
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("saving webhook delivery %d: %v", delivery.ID, err)
+	}
+
+	return deliveryErr
+}
+
+func postWebhook(ctx context.Context, appCtx Context, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", fmt.Sprintf("%s-webhooks", appCtx.cfg.Name))
+	req.Header.Set("X-Webhook-Event", delivery.EventType)
+	req.Header.Set("X-Webhook-Delivery", delivery.EventID)
+	req.Header.Set("X-Signature-256", hex.EncodeToString(signPayload(endpoint.Secret, delivery.Payload)))
+
+	res, err := appCtx.webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("endpoint responded with %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+// retryWebhookDeliveries periodically attempts the deliveries which are due for a retry until ctx is done.
+func retryWebhookDeliveries(ctx context.Context, appCtx Context) {
+	interval := time.Duration(appCtx.cfg.WebhookRetryIntervalSecs) * time.Second
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deliveries, err := appCtx.db.WebhookDelivery.Query().
+				Where(
+					webhookdelivery.DeliveredAtIsNil(),
+					webhookdelivery.NextAttemptAtLTE(time.Now()),
+				).
+				Order(models.Asc(webhookdelivery.FieldNextAttemptAt)).
+				Limit(100).
+				All(ctx)
+			if err != nil {
+				log.Printf("webhook delivery retry: querying deliveries: %v\n", err)
+				continue
+			}
+			for _, delivery := range deliveries {
+				endpoint, err := appCtx.db.WebhookEndpoint.Get(ctx, delivery.EndpointID)
+				if err != nil {
+					if models.IsNotFound(err) {
+						// the endpoint was removed, give up on the delivery
+						_ = delivery.Update().SetError("endpoint was removed").ClearNextAttemptAt().Exec(ctx)
+						continue
+					}
+					log.Printf("webhook delivery retry: querying endpoint: %v\n", err)
+					continue
+				}
+				if err := deliverWebhook(ctx, appCtx, endpoint, delivery); err != nil {
+					log.Printf("webhook delivery retry: %s to %s attempt %d: %v\n", delivery.EventID, endpoint.URL, delivery.Attempts+1, err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+)
+
+// webhookReceiver records the deliveries and responds with the given status codes in turn, 200 once they run out.
+type webhookReceiver struct {
+	*httptest.Server
+	mu        sync.Mutex
+	statuses  []int
+	requests  []*http.Request
+	bodies    [][]byte
+	delivered chan struct{}
+}
+
+func newWebhookReceiver(t *testing.T, statuses ...int) *webhookReceiver {
+	rec := &webhookReceiver{statuses: statuses, delivered: make(chan struct{}, 10)}
+	rec.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		rec.mu.Lock()
+		rec.requests = append(rec.requests, r)
+		rec.bodies = append(rec.bodies, body)
+		status := http.StatusOK
+		if len(rec.statuses) > 0 {
+			status, rec.statuses = rec.statuses[0], rec.statuses[1:]
+		}
+		rec.mu.Unlock()
+		w.WriteHeader(status)
+		rec.delivered <- struct{}{}
+	}))
+	t.Cleanup(rec.Close)
+	return rec
+}
+
+// withWebhookReceiver allows delivering to the receiver on the loopback address and registers it for the owner.
+func withWebhookReceiver(t *testing.T, appCtx Context, owner string, rec *webhookReceiver) (Context, *models.WebhookEndpoint) {
+	t.Helper()
+	appCtx.cfg.WebhookAllowPrivateURLs = true
+	appCtx.cfg.WebhookMaxAttempts = 3
+	appCtx.cfg.WebhookRetryIntervalSecs = 30
+	appCtx.webhookClient = newWebhookClient(true)
+	endpoint, err := appCtx.db.WebhookEndpoint.Create().
+		SetID(shortuuid.New()).
+		SetOwner(owner).
+		SetURL(rec.URL).
+		SetSecret("whsec_test").
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return appCtx, endpoint
+}
+
+func TestTaskWebhookDelivery(t *testing.T) {
+	appCtx := newTestContext(t)
+	accountID, token := newTestAccount(t, appCtx, "owner@example.com")
+	rec := newWebhookReceiver(t)
+	appCtx, endpoint := withWebhookReceiver(t, appCtx, accountID, rec)
+	api := newTestAPI(t, appCtx)
+
+	created := new(taskResponse)
+	if w := apiRequest(t, api, token, http.MethodPost, "/tasks", map[string]string{"text": "hooked"}, created); w.Code != http.StatusOK {
+		t.Fatalf("create: got %d: %s", w.Code, w.Body.String())
+	}
+
+	select {
+	case <-rec.delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the webhook wasn't delivered")
+	}
+
+	rec.mu.Lock()
+	req, body := rec.requests[0], rec.bodies[0]
+	rec.mu.Unlock()
+
+	signature, err := hex.DecodeString(req.Header.Get("X-Signature-256"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hmac.Equal(signature, signPayload(endpoint.Secret, body)) {
+		t.Fatal("the signature doesn't match the payload")
+	}
+	if req.Header.Get("X-Webhook-Event") != taskCreatedEvent {
+		t.Fatalf("got event %s", req.Header.Get("X-Webhook-Event"))
+	}
+
+	var event struct {
+		ID   string       `json:"id"`
+		Type string       `json:"type"`
+		Data taskResponse `json:"data"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != taskCreatedEvent || event.Data.ID != created.ID || event.ID != req.Header.Get("X-Webhook-Delivery") {
+		t.Fatalf("got event %+v", event)
+	}
+
+	// the delivery is recorded once the receiver's response is handled
+	var logs []webhookDeliveryLog
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		_, logs, err = webhookEndpoints(context.Background(), appCtx, accountID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(logs) == 1 && logs[0].DeliveredAt != nil {
+			break
+		}
+	}
+	if len(logs) != 1 || logs[0].DeliveredAt == nil {
+		t.Fatalf("got %d deliveries, want one delivered", len(logs))
+	}
+	if logs[0].URL != rec.URL || logs[0].StatusCode != http.StatusOK || logs[0].Attempts != 1 || logs[0].NextAttemptAt != nil {
+		t.Fatalf("got delivery %+v", logs[0].WebhookDelivery)
+	}
+}
+
+func TestTaskWebhookRetries(t *testing.T) {
+	appCtx := newTestContext(t)
+	rec := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusBadGateway)
+	appCtx, endpoint := withWebhookReceiver(t, appCtx, "owner", rec)
+	ctx := context.Background()
+
+	delivery, err := appCtx.db.WebhookDelivery.Create().
+		SetEndpointID(endpoint.ID).
+		SetEventID("evt_1").
+		SetEventType(taskUpdatedEvent).
+		SetPayload([]byte(`{"id":"evt_1"}`)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for attempt, want := range []struct {
+		status  int
+		backoff time.Duration
+	}{
+		{http.StatusInternalServerError, 30 * time.Second},
+		{http.StatusBadGateway, 60 * time.Second},
+		{http.StatusOK, 0},
+	} {
+		before := time.Now()
+		err := deliverWebhook(ctx, appCtx, endpoint, delivery)
+		if (err == nil) != (want.status == http.StatusOK) {
+			t.Fatalf("attempt %d: got %v", attempt+1, err)
+		}
+		delivery, err = appCtx.db.WebhookDelivery.Get(ctx, delivery.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if delivery.Attempts != attempt+1 || delivery.StatusCode != want.status {
+			t.Fatalf("attempt %d: got %d attempts and status %d", attempt+1, delivery.Attempts, delivery.StatusCode)
+		}
+		if want.backoff == 0 {
+			if delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil || delivery.Error != "" {
+				t.Fatalf("attempt %d: got %+v, want it delivered", attempt+1, delivery)
+			}
+			continue
+		}
+		if delivery.NextAttemptAt == nil {
+			t.Fatalf("attempt %d: no retry scheduled", attempt+1)
+		}
+		backoff := delivery.NextAttemptAt.Sub(before)
+		if backoff < want.backoff || backoff > want.backoff+5*time.Second {
+			t.Fatalf("attempt %d: got backoff %s, want %s", attempt+1, backoff, want.backoff)
+		}
+	}
+}
+
+func TestTaskWebhookGivesUpAfterMaxAttempts(t *testing.T) {
+	appCtx := newTestContext(t)
+	rec := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+	appCtx, endpoint := withWebhookReceiver(t, appCtx, "owner", rec)
+	ctx := context.Background()
+
+	delivery, err := appCtx.db.WebhookDelivery.Create().
+		SetEndpointID(endpoint.ID).
+		SetEventID("evt_1").
+		SetEventType(taskDeletedEvent).
+		SetPayload([]byte(`{"id":"evt_1"}`)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < appCtx.cfg.WebhookMaxAttempts; i++ {
+		_ = deliverWebhook(ctx, appCtx, endpoint, delivery)
+		delivery, err = appCtx.db.WebhookDelivery.Get(ctx, delivery.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if delivery.NextAttemptAt != nil || delivery.DeliveredAt != nil || delivery.Error == "" {
+		t.Fatalf("got %+v, want the delivery to be given up", delivery)
+	}
+}
+
+func TestTaskWebhookDeliveredOnce(t *testing.T) {
+	appCtx := newTestContext(t)
+	rec := newWebhookReceiver(t)
+	appCtx, endpoint := withWebhookReceiver(t, appCtx, "owner", rec)
+	ctx := context.Background()
+
+	delivery, err := appCtx.db.WebhookDelivery.Create().
+		SetEndpointID(endpoint.ID).
+		SetEventID("evt_1").
+		SetEventType(taskCreatedEvent).
+		SetPayload([]byte(`{"id":"evt_1"}`)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the goroutine of emitTaskEvent and a retry worker which read the delivery before it was attempted
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := deliverWebhook(ctx, appCtx, endpoint, delivery); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if len(rec.requests) != 1 {
+		t.Fatalf("got %d requests, want the delivery to be posted once", len(rec.requests))
+	}
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	cfg := Config{WebhookRetryIntervalSecs: 30}
+	for attempts, want := range map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		5:  8 * time.Minute,
+		20: maxWebhookRetryBackoff,
+	} {
+		if got := webhookRetryBackoff(cfg, attempts); got != want {
+			t.Errorf("attempt %d: got %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestCreateWebhookEndpointRejectsPrivateURLs(t *testing.T) {
+	appCtx := newTestContext(t)
+	accountID, _ := newTestAccount(t, appCtx, "owner@example.com")
+
+	for _, rawURL := range []string{"http://127.0.0.1:8080/hook", "http://169.254.169.254/latest", "http://[::1]/hook"} {
+		form := url.Values{"URL": {rawURL}}
+		req := newTestWorkspaceRequest(t, appCtx, accountID, http.MethodPost, "/account/webhooks", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		data, err := createWebhookEndpoint(appCtx)(httptest.NewRecorder(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := data["field_errors"].(fieldErrors)["url"]; !ok {
+			t.Fatalf("%s: got %v, want a url error", rawURL, data)
+		}
+	}
+
+	count, err := appCtx.db.WebhookEndpoint.Query().Where(webhookendpoint.Owner(accountID)).Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("got %d endpoints, want none", count)
+	}
+}
+
+func TestWebhookEndpointsOnAccountPage(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.cfg.WebhookAllowPrivateURLs = true
+	ctx := context.Background()
+	ownerID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	memberID, _ := newTestAccount(t, appCtx, "member@example.com")
+
+	form := url.Values{"URL": {"https://example.com/hook"}}
+	req := newTestWorkspaceRequest(t, appCtx, ownerID, http.MethodPost, "/account/webhooks", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	data, err := createWebhookEndpoint(appCtx)(httptest.NewRecorder(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint, err := appCtx.db.WebhookEndpoint.Query().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data["webhook_secret"] != endpoint.Secret {
+		t.Fatalf("got %v, want the secret in the response to the creation", data)
+	}
+
+	workspaceID := workspaceIDFromContext(req)
+	_, err = appCtx.db.Membership.Create().
+		SetID(shortuuid.New()).
+		SetAccountID(memberID).
+		SetEmail("member@example.com").
+		SetRole(membership.RoleMember).
+		SetWorkspaceID(workspaceID).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		accountID string
+		manage    bool
+	}{
+		{ownerID, true},
+		{memberID, false},
+	} {
+		req := newTestWorkspaceRequest(t, appCtx, tt.accountID, http.MethodGet, "/account", nil)
+		req = req.WithContext(context.WithValue(req.Context(), workspaceIDKey, workspaceID))
+		data, err := accountPage(appCtx)(httptest.NewRecorder(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := data["webhook_secret"]; ok {
+			t.Fatal("the secret is shown after its creation")
+		}
+		endpoints := data["webhook_endpoints"].([]*models.WebhookEndpoint)
+		if data["can_manage_webhooks"] != tt.manage || (len(endpoints) == 1) != tt.manage {
+			t.Fatalf("%s: got %d endpoints, want them listed only to the managers", tt.accountID, len(endpoints))
+		}
+	}
+}
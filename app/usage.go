@@ -0,0 +1,165 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+	"github.com/adnaan/gomodest-starter/app/gen/models/usage"
+)
+
+const apiCallsFeature = "api_calls"
+
+type featureUsage struct {
+	ID    string
+	Title string
+	Used  int64
+	Limit interface{}
+}
+
+// usagePeriod returns the billing period usage is counted in. Periods are calendar months in UTC.
+func usagePeriod(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// incrementUsage adds n to the usage counter of the feature for the current period.
+// The counter is updated in place (count = count + n) so concurrent increments are never lost. If the counter doesn't
+// exist yet it's created, and if a concurrent request created it first the update is retried.
+func incrementUsage(ctx context.Context, db *models.Client, owner, featureID string, n int64) error {
+	period := usagePeriod(time.Now())
+	for attempt := 0; attempt < 3; attempt++ {
+		updated, err := db.Usage.Update().
+			Where(usage.Owner(owner), usage.FeatureID(featureID), usage.Period(period)).
+			AddCount(n).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated > 0 {
+			return nil
+		}
+
+		_, err = db.Usage.Create().
+			SetOwner(owner).
+			SetFeatureID(featureID).
+			SetPeriod(period).
+			SetCount(n).
+			Save(ctx)
+		if err == nil {
+			return nil
+		}
+		if !models.IsConstraintError(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("incrementing usage of %s for %s failed", featureID, owner)
+}
+
+// consumeUsage counts a use of the quota feature or returns a limitError if the plan's quota for the current period
+// is used up. The check and the increment are a single conditional update (count = count + 1 where count < limit),
+// so concurrent requests can't exceed the quota.
+func consumeUsage(ctx context.Context, db *models.Client, cfg Config, plan Plan, owner, featureID string) error {
+	limit, ok := intFeatureLimit(cfg, plan, featureID)
+	if !ok {
+		return incrementUsage(ctx, db, owner, featureID, 1)
+	}
+
+	limitErr := &limitError{Plan: plan.Name, Feature: featureID, Limit: limit}
+	period := usagePeriod(time.Now())
+	for attempt := 0; attempt < 3; attempt++ {
+		updated, err := db.Usage.Update().
+			Where(usage.Owner(owner), usage.FeatureID(featureID), usage.Period(period), usage.CountLT(limit)).
+			AddCount(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated > 0 {
+			return nil
+		}
+
+		// nothing was updated, either the quota is used up or the counter doesn't exist yet
+		exists, err := db.Usage.Query().
+			Where(usage.Owner(owner), usage.FeatureID(featureID), usage.Period(period)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists || limit <= 0 {
+			return limitErr
+		}
+
+		_, err = db.Usage.Create().
+			SetOwner(owner).
+			SetFeatureID(featureID).
+			SetPeriod(period).
+			SetCount(1).
+			Save(ctx)
+		if err == nil {
+			return nil
+		}
+		if !models.IsConstraintError(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("counting usage of %s for %s failed", featureID, owner)
+}
+
+// meterAPICalls counts the call against the api calls quota of the current workspace and rejects the request if the
+// quota of its plan has been used up. It expects withWorkspace to run before.
+func meterAPICalls(appCtx Context) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			err := consumeUsage(r.Context(), appCtx.db, appCtx.cfg, currentPlan(appCtx, r), workspaceIDFromContext(r), apiCallsFeature)
+			if err != nil {
+				renderLimitErr(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// accountUsage lists the usage of the quota features by the workspace for the current period along with the limits of
+// its plan.
+func accountUsage(ctx context.Context, appCtx Context, plan Plan, workspaceID string) ([]featureUsage, error) {
+	usages, err := appCtx.db.Usage.Query().
+		Where(usage.Owner(workspaceID), usage.Period(usagePeriod(time.Now()))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]int64)
+	for _, u := range usages {
+		used[u.FeatureID] = u.Count
+	}
+
+	tasks, err := appCtx.db.Task.Query().Where(task.Owner(workspaceID)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used[tasksFeature] = int64(tasks)
+
+	var featureUsages []featureUsage
+	for _, group := range appCtx.cfg.FeatureGroups {
+		for _, feature := range group.Features {
+			if feature.ValueType != intValueType {
+				continue
+			}
+			featureUsages = append(featureUsages, featureUsage{
+				ID:    feature.ID,
+				Title: feature.Title,
+				Used:  used[feature.ID],
+				Limit: plan.Features[feature.ID],
+			})
+		}
+	}
+
+	return featureUsages, nil
+}
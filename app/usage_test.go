@@ -0,0 +1,233 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+	"github.com/adnaan/gomodest-starter/app/gen/models/usage"
+)
+
+func withAPICallsQuota(appCtx Context, limit float64) Context {
+	appCtx.cfg.FeatureGroups = []FeatureGroup{{
+		Name:     "api",
+		Features: []Feature{{ID: apiCallsFeature, Title: "API calls", ValueType: intValueType}},
+	}}
+	appCtx.cfg.Plans = []Plan{{PriceID: freePriceID, Name: "Free", Features: map[string]interface{}{apiCallsFeature: limit}}}
+	return appCtx
+}
+
+func TestConsumeUsage(t *testing.T) {
+	appCtx := withAPICallsQuota(newTestContext(t), 3)
+	ctx := context.Background()
+	plan := planByPriceID(appCtx.cfg.Plans, freePriceID)
+
+	for i := 0; i < 5; i++ {
+		err := consumeUsage(ctx, appCtx.db, appCtx.cfg, plan, "owner", apiCallsFeature)
+		var limitErr *limitError
+		if i < 3 && err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if i >= 3 && !errors.As(err, &limitErr) {
+			t.Fatalf("call %d: got %v, want a limit error", i, err)
+		}
+	}
+
+	u, err := appCtx.db.Usage.Query().Where(usage.Owner("owner"), usage.FeatureID(apiCallsFeature)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Count != 3 {
+		t.Fatalf("got count %d, want 3", u.Count)
+	}
+}
+
+func TestMeterAPICalls(t *testing.T) {
+	appCtx := withAPICallsQuota(newTestContext(t), 2)
+	api := newTestAPI(t, appCtx)
+	_, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusForbidden} {
+		if w := apiRequest(t, api, token, http.MethodGet, "/tasks", nil, nil); w.Code != want {
+			t.Fatalf("call %d: got %d, want %d: %s", i, w.Code, want, w.Body.String())
+		}
+	}
+
+	// the calls with the session cookie are metered the same
+	accountID, _ := newTestAccount(t, appCtx, "member@example.com")
+	metered := meterAPICalls(appCtx)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusForbidden} {
+		w := serve(metered, newTestWorkspaceRequest(t, appCtx, accountID, http.MethodGet, "/tasks", nil))
+		if w.Code != want {
+			t.Fatalf("session call %d: got %d, want %d", i, w.Code, want)
+		}
+	}
+}
+
+func TestWorkspacePlanAndUsage(t *testing.T) {
+	appCtx := withAPICallsQuota(newTestContext(t), 1)
+	appCtx.cfg.Plans = append(appCtx.cfg.Plans, Plan{PriceID: "price_pro", Name: "Pro", Features: map[string]interface{}{apiCallsFeature: float64(2)}})
+	ctx := context.Background()
+	ownerID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	memberID, memberToken := newTestAccount(t, appCtx, "member@example.com")
+
+	// the owner is subscribed, the member isn't
+	_, err := appCtx.db.Subscription.Create().
+		SetID("sub_test_1").
+		SetBillingID("cus_test_1").
+		SetStatus("active").
+		SetPriceID("price_pro").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = appCtx.accounts.Account.UpdateOneID(uuid.MustParse(ownerID)).
+		SetAttributes(map[string]interface{}{billingIDKey: "cus_test_1"}).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	team, err := createWorkspace(ctx, appCtx, "team", ownerID, "Team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = appCtx.db.Membership.Create().
+		SetID("membership_1").
+		SetAccountID(memberID).
+		SetEmail("member@example.com").
+		SetRole(membership.RoleMember).
+		SetWorkspace(team).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan := workspacePlan(ctx, appCtx, team.ID); plan.PriceID != "price_pro" {
+		t.Fatalf("got the %s plan in the shared workspace, want the plan of its owner", plan.Name)
+	}
+	if _, err := ensurePersonalWorkspace(ctx, appCtx, memberID); err != nil {
+		t.Fatal(err)
+	}
+	if plan := workspacePlan(ctx, appCtx, memberID); plan.PriceID != freePriceID {
+		t.Fatalf("got the %s plan in the personal workspace of the member", plan.Name)
+	}
+
+	// the calls of the member in the shared workspace count against its quota, not the member's
+	api := newTestAPI(t, appCtx)
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusForbidden} {
+		req := newAPIRequest(t, memberToken, http.MethodGet, "/tasks", nil)
+		req.Header.Set(workspaceHeader, team.ID)
+		if w := serve(api, req); w.Code != want {
+			t.Fatalf("call %d: got %d, want %d: %s", i, w.Code, want, w.Body.String())
+		}
+	}
+	if w := apiRequest(t, api, memberToken, http.MethodGet, "/tasks", nil, nil); w.Code != http.StatusOK {
+		t.Fatalf("personal workspace: got %d, want %d", w.Code, http.StatusOK)
+	}
+	usages, err := accountUsage(ctx, appCtx, workspacePlan(ctx, appCtx, team.ID), team.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usages) != 1 || usages[0].Used != 2 {
+		t.Fatalf("got usage %+v, want the 2 calls in the shared workspace", usages)
+	}
+}
+
+func TestForbiddenAPICallsAreNotMetered(t *testing.T) {
+	appCtx := withAPICallsQuota(newTestContext(t), 1)
+	api := newTestAPI(t, appCtx)
+	ownerID, ownerToken := newTestAccount(t, appCtx, "owner@example.com")
+	guestID, guestToken := newTestAccount(t, appCtx, "guest@example.com")
+
+	personal, err := ensurePersonalWorkspace(context.Background(), appCtx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = appCtx.db.Membership.Create().
+		SetID("membership_guest").
+		SetAccountID(guestID).
+		SetEmail("guest@example.com").
+		SetRole(membership.RoleGuest).
+		SetWorkspace(personal).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		req := newAPIRequest(t, guestToken, http.MethodPost, "/tasks", map[string]string{"text": "guest task"})
+		req.Header.Set(workspaceHeader, personal.ID)
+		if w := serve(api, req); w.Code != http.StatusForbidden {
+			t.Fatalf("guest call %d: got %d, want %d", i, w.Code, http.StatusForbidden)
+		}
+	}
+	if w := apiRequest(t, api, ownerToken, http.MethodGet, "/tasks", nil, nil); w.Code != http.StatusOK {
+		t.Fatalf("got %d, want the quota to be left for the allowed call: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestTaskLimit(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.cfg.FeatureGroups = []FeatureGroup{{
+		Name:     "tasks",
+		Features: []Feature{{ID: tasksFeature, Title: "Tasks", ValueType: intValueType}},
+	}}
+	appCtx.cfg.Plans = []Plan{{PriceID: freePriceID, Name: "Free", Features: map[string]interface{}{tasksFeature: float64(2)}}}
+	api := newTestAPI(t, appCtx)
+	accountID, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusForbidden} {
+		if w := apiRequest(t, api, token, http.MethodPost, "/tasks", map[string]string{"text": "limited"}, nil); w.Code != want {
+			t.Fatalf("create %d: got %d, want %d: %s", i, w.Code, want, w.Body.String())
+		}
+	}
+
+	text := "batched"
+	res := new(batchTasksResponse)
+	w := apiRequest(t, api, token, http.MethodPost, "/tasks/batch", batchTasksRequest{
+		Mode:       bestEffortBatchMode,
+		Operations: []batchTaskOperation{{Op: createBatchOp, Text: &text}},
+	}, res)
+	if w.Code != http.StatusOK || res.Results[0].Status != http.StatusForbidden {
+		t.Fatalf("got %d: %s, want the batched create to be over the limit", w.Code, w.Body.String())
+	}
+
+	count, err := appCtx.db.Task.Query().Where(task.Owner(accountID)).Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("got %d tasks, want 2", count)
+	}
+}
+
+func TestLockWorkspace(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+	accountID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	if _, err := ensurePersonalWorkspace(ctx, appCtx, accountID); err != nil {
+		t.Fatal(err)
+	}
+
+	err := withTx(ctx, appCtx, func(txCtx Context) error {
+		if err := lockWorkspace(ctx, txCtx, accountID); err != nil {
+			t.Fatal(err)
+		}
+		// sqlite doesn't wait for the lock in a shared memory database, it fails right away
+		if err := lockWorkspace(ctx, appCtx, accountID); err == nil {
+			t.Fatal("the workspace was locked twice")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lockWorkspace(ctx, appCtx, "missing"); err == nil {
+		t.Fatal("got no error for a missing workspace")
+	}
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	rl "github.com/adnaan/renderlayout"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+)
+
+const maxTaskTextLength = 1000
+
+// fieldErrors maps a payload field to the reason it failed validation.
+type fieldErrors map[string]string
+
+func (fe fieldErrors) Error() string {
+	return strings.Join(fe.messages(), "; ")
+}
+
+// messages returns the field errors sorted by field name.
+func (fe fieldErrors) messages() []string {
+	var fields []string
+	for field := range fe {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var messages []string
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%s %s", field, fe[field]))
+	}
+	return messages
+}
+
+// validator collects the first error of every field of a payload.
+type validator struct {
+	errs fieldErrors
+}
+
+func (v *validator) check(ok bool, field, message string) {
+	if ok {
+		return
+	}
+	if v.errs == nil {
+		v.errs = make(fieldErrors)
+	}
+	if _, exists := v.errs[field]; !exists {
+		v.errs[field] = message
+	}
+}
+
+func (v *validator) required(field, value string) {
+	v.check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+func (v *validator) maxLength(field, value string, max int) {
+	v.check(utf8.RuneCountInString(value) <= max, field, fmt.Sprintf("must be at most %d characters", max))
+}
+
+func (v *validator) taskStatus(field, value string) {
+	v.check(task.StatusValidator(task.Status(value)) == nil, field,
+		fmt.Sprintf("must be one of %s, %s, %s", task.StatusTodo, task.StatusInprogress, task.StatusDone))
+}
+
+func (v *validator) taskPriority(field, value string) {
+	v.check(task.PriorityValidator(task.Priority(value)) == nil, field,
+		fmt.Sprintf("must be one of %s, %s, %s", task.PriorityLow, task.PriorityMedium, task.PriorityHigh))
+}
+
+func (v *validator) taskText(field, value string) {
+	v.required(field, value)
+	v.maxLength(field, value, maxTaskTextLength)
+}
+
+// err returns the collected fieldErrors or nil if the payload is valid.
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// formErrors shows validation errors of a html form in the errors partial, one per field.
+// Any other error is wrapped to be shown as is.
+func formErrors(err error) (rl.D, error) {
+	if fe, ok := err.(fieldErrors); ok {
+		return rl.D{
+			"errors":       fe.messages(),
+			"field_errors": fe,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w", err)
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestValidator(t *testing.T) {
+	v := new(validator)
+	v.taskText("text", "   ")
+	// only the first error of a field is kept
+	v.maxLength("text", strings.Repeat("a", 2), 1)
+	v.taskText("title", strings.Repeat("é", maxTaskTextLength))
+	v.taskStatus("status", "doing")
+	v.taskPriority("priority", "urgent")
+
+	fe, ok := v.err().(fieldErrors)
+	if !ok {
+		t.Fatalf("got %v, want field errors", v.err())
+	}
+	want := fieldErrors{
+		"text":     "is required",
+		"status":   "must be one of todo, inprogress, done",
+		"priority": "must be one of low, medium, high",
+	}
+	if len(fe) != len(want) {
+		t.Fatalf("got %v, want %v", fe, want)
+	}
+	for field, message := range want {
+		if fe[field] != message {
+			t.Errorf("%s: got %q, want %q", field, fe[field], message)
+		}
+	}
+	if got := fe.Error(); got != "priority must be one of low, medium, high; status must be one of todo, inprogress, done; text is required" {
+		t.Errorf("got %q, want the errors sorted by field", got)
+	}
+
+	v = new(validator)
+	v.taskText("text", strings.Repeat("é", maxTaskTextLength+1))
+	if err := v.err(); err == nil {
+		t.Fatal("got no error for a text over the maximum length")
+	}
+	v = new(validator)
+	v.taskStatus("status", "done")
+	if err := v.err(); err != nil {
+		t.Fatalf("got %v for a valid status", err)
+	}
+}
+
+func TestTaskAPIValidation(t *testing.T) {
+	appCtx := newTestContext(t)
+	api := newTestAPI(t, appCtx)
+	_, token := newTestAccount(t, appCtx, "owner@example.com")
+
+	created := new(taskResponse)
+	apiRequest(t, api, token, http.MethodPost, "/tasks", map[string]string{"text": "a task"}, created)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   interface{}
+		want   int
+		field  string
+	}{
+		{"empty text", http.MethodPost, "/tasks", map[string]string{"text": " "}, http.StatusUnprocessableEntity, "text"},
+		{"long text", http.MethodPost, "/tasks", map[string]string{"text": strings.Repeat("a", maxTaskTextLength+1)}, http.StatusUnprocessableEntity, "text"},
+		{"unknown priority", http.MethodPost, "/tasks", map[string]string{"text": "a task", "priority": "urgent"}, http.StatusUnprocessableEntity, "priority"},
+		{"unknown status", http.MethodPut, "/tasks/" + created.ID + "/status", map[string]string{"status": "doing"}, http.StatusUnprocessableEntity, "status"},
+		{"empty text update", http.MethodPut, "/tasks/" + created.ID + "/text", map[string]string{"text": ""}, http.StatusUnprocessableEntity, "text"},
+		{"patched status", http.MethodPatch, "/tasks/" + created.ID, map[string]string{"status": "doing"}, http.StatusUnprocessableEntity, "status"},
+		{"malformed json", http.MethodPost, "/tasks", "{", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		req := newAPIRequest(t, token, tt.method, tt.path, tt.body)
+		if raw, ok := tt.body.(string); ok {
+			req = httptest.NewRequest(tt.method, tt.path, strings.NewReader(raw))
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", "application/json")
+		}
+		w := serve(api, req)
+		if w.Code != tt.want {
+			t.Errorf("%s: got %d, want %d: %s", tt.name, w.Code, tt.want, w.Body.String())
+			continue
+		}
+		res := new(ErrResponse)
+		if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+			t.Fatal(err)
+		}
+		if tt.field != "" && res.Fields[tt.field] == "" {
+			t.Errorf("%s: got fields %v, want an error for %s", tt.name, res.Fields, tt.field)
+		}
+	}
+}
+
+func TestTaskFormValidation(t *testing.T) {
+	appCtx := newTestContext(t)
+	accountID, _ := newTestAccount(t, appCtx, "owner@example.com")
+
+	form := url.Values{"Text": {""}, "Priority": {"urgent"}}
+	req := newTestWorkspaceRequest(t, appCtx, accountID, http.MethodPost, "/app/tasks/new", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	event, data, err := createNewTask(appCtx)(httptest.NewRecorder(), req)
+	if err != nil || event != nil {
+		t.Fatalf("got %v %v, want the field errors shown in the form", event, err)
+	}
+	fe, ok := data["field_errors"].(fieldErrors)
+	if !ok || fe["text"] == "" || fe["priority"] == "" {
+		t.Fatalf("got field errors %v, want text and priority", data["field_errors"])
+	}
+	if messages, _ := data["errors"].([]string); len(messages) != 2 {
+		t.Fatalf("got errors %v, want one per field", data["errors"])
+	}
+
+	// other errors aren't shown as field errors
+	if _, err := formErrors(errors.New("boom")); err == nil {
+		t.Fatal("got no error, want it passed through")
+	}
+}
@@ -1,13 +1,14 @@
 package app
 
 import (
+	"context"
 	"io/ioutil"
 	"log"
 	"net/http"
 
 	"github.com/go-chi/render"
 
-	"github.com/stripe/stripe-go/v72/webhook"
+	"github.com/adnaan/gomodest-starter/app/gen/models"
 
 	"github.com/go-chi/chi"
 )
@@ -15,29 +16,77 @@ import (
 func handleWebhook(appCtx Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		source := chi.URLParam(r, "source")
+		webhookSource, ok := appCtx.webhooks[source]
+		if !ok {
+			render.Render(w, r, ErrNotFound)
+			return
+		}
 
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			log.Printf("ioutil.ReadAll: %v", err)
 			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, &errResponse{err.Error()})
 			return
 		}
 
-		switch source {
-		case "stripe":
-			event, err := webhook.ConstructEvent(b, r.Header.Get("Stripe-Signature"), appCtx.cfg.StripeWebhookSecret)
-			if err != nil {
-				log.Printf("webhook.ConstructEvent: %v", err)
-				render.Status(r, http.StatusBadRequest)
-				return
-			}
-
-			if event.Type != "checkout.session.completed" {
-				return
-			}
+		err = webhookSource.Verify(r, b)
+		if err != nil {
+			// the rejected requests aren't audited, anyone can post them and fill up the audit log
+			log.Printf("webhook %s Verify: %v", source, err)
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, &errResponse{err.Error()})
+			return
+		}
 
-			log.Printf("webhook %+v\n", event)
+		eventID, eventType, err := webhookSource.Parse(r, b)
+		if err != nil {
+			log.Printf("webhook %s Parse: %v", source, err)
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, &errResponse{err.Error()})
+			return
 		}
 
+		ingestWebhookEvent(w, r, appCtx, source, eventID, eventType, b)
+	}
+}
+
+// ingestWebhookEvent stores the event before processing it. A duplicate event is acknowledged without being
+// processed again. Once stored, a failed event is retried in the background instead of by the sender.
+func ingestWebhookEvent(w http.ResponseWriter, r *http.Request, appCtx Context, source, eventID, eventType string, payload []byte) {
+	ev, duplicate, err := storeWebhookEvent(r.Context(), appCtx.db, source, eventID, eventType, payload)
+	if err != nil {
+		// a non 2xx response makes the sender retry the event
+		log.Printf("storeWebhookEvent %s %s: %v", source, eventID, err)
+		render.Status(r, http.StatusInternalServerError)
+		render.JSON(w, r, &errResponse{err.Error()})
+		return
+	}
+
+	if duplicate {
+		log.Printf("webhook: acknowledging duplicate %s event %s", source, eventID)
+		return
+	}
+
+	err = processWebhookEvent(r.Context(), appCtx, ev)
+	if err != nil {
+		log.Printf("processWebhookEvent %s %s: %v", source, eventID, err)
+	}
+	// audited once processed, the event may create the subscription which resolves its account
+	recordAuditEvent(r, appCtx, webhookEventAccountID(r.Context(), appCtx, ev), webhookActorPrefix+source,
+		auditWebhookReceived, auditDiff{"event_id": eventID, "event_type": eventType})
+}
+
+// webhookEventAccountID returns the account concerned by the event if its source resolves it.
+func webhookEventAccountID(ctx context.Context, appCtx Context, ev *models.WebhookEvent) string {
+	source, ok := appCtx.webhooks[ev.Source].(accountWebhookSource)
+	if !ok {
+		return ""
+	}
+	accountID, err := source.AccountID(ctx, appCtx, ev)
+	if err != nil {
+		log.Printf("webhookEventAccountID %s %s: %v", ev.Source, ev.EventID, err)
+		return ""
 	}
+	return accountID
 }
@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+const (
+	maxWebhookRetryBackoff = 6 * time.Hour
+	// a stored event which isn't processed within the grace period is retried, e.g. if the app stopped before
+	// processing it. The sender's redelivery of the event is acknowledged as a duplicate, so it would be lost otherwise.
+	webhookProcessingGrace = time.Minute
+	// a claim on an event or a delivery which isn't released within the lease was left by a stopped app, the row can be
+	// claimed again. It's longer than the timeout of the webhook client.
+	webhookClaimLease = 2 * time.Minute
+)
+
+// storeWebhookEvent records a verified incoming event before it's processed.
+// If the event has been received before, the stored event is returned with duplicate set to true.
+func storeWebhookEvent(ctx context.Context, db *models.Client, source, eventID, eventType string, payload []byte) (ev *models.WebhookEvent, duplicate bool, err error) {
+	ev, err = db.WebhookEvent.Create().
+		SetSource(source).
+		SetEventID(eventID).
+		SetType(eventType).
+		SetPayload(payload).
+		SetNextAttemptAt(time.Now().Add(webhookProcessingGrace)).
+		Save(ctx)
+	if err == nil {
+		return ev, false, nil
+	}
+	if !models.IsConstraintError(err) {
+		return nil, false, err
+	}
+
+	ev, err = db.WebhookEvent.Query().
+		Where(webhookevent.Source(source), webhookevent.EventID(eventID)).
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return ev, true, nil
+}
+
+// dispatchWebhookEvent processes a stored event with the webhook source it was received from.
+func dispatchWebhookEvent(ctx context.Context, appCtx Context, ev *models.WebhookEvent) error {
+	webhookSource, ok := appCtx.webhooks[ev.Source]
+	if !ok {
+		return fmt.Errorf("unknown webhook source %s", ev.Source)
+	}
+	return webhookSource.Dispatch(ctx, appCtx, ev)
+}
+
+// claimWebhookEvent marks the event as being processed unless another request or instance claimed it or attempted it
+// since it was read. Only the claimer processes the event, so it isn't applied twice.
+func claimWebhookEvent(ctx context.Context, db *models.Client, ev *models.WebhookEvent) (bool, error) {
+	now := time.Now()
+	claimed, err := db.WebhookEvent.Update().
+		Where(
+			webhookevent.ID(ev.ID),
+			webhookevent.Attempts(ev.Attempts),
+			webhookevent.Or(webhookevent.ClaimedAtIsNil(), webhookevent.ClaimedAtLT(now.Add(-webhookClaimLease))),
+		).
+		SetClaimedAt(now).
+		Save(ctx)
+	return claimed > 0, err
+}
+
+// processWebhookEvent dispatches the event and records the outcome. A failed event is scheduled to be retried
+// with an exponential backoff until the configured number of attempts is reached. An event claimed by someone else is
+// skipped.
+func processWebhookEvent(ctx context.Context, appCtx Context, ev *models.WebhookEvent) error {
+	claimed, err := claimWebhookEvent(ctx, appCtx.db, ev)
+	if err != nil {
+		return fmt.Errorf("claiming webhook event %d: %v", ev.ID, err)
+	}
+	if !claimed {
+		log.Printf("webhook: %s event %s is processed elsewhere, skipping\n", ev.Source, ev.EventID)
+		return nil
+	}
+
+	dispatchErr := dispatchWebhookEvent(ctx, appCtx, ev)
+	attempts := ev.Attempts + 1
+
+	update := ev.Update().SetAttempts(attempts).ClearClaimedAt()
+	if dispatchErr == nil {
+		update.SetProcessedAt(time.Now()).
+			ClearError().
+			ClearNextAttemptAt()
+	} else {
+		update.SetError(dispatchErr.Error())
+		if attempts < appCtx.cfg.WebhookMaxAttempts {
+			update.SetNextAttemptAt(time.Now().Add(webhookRetryBackoff(appCtx.cfg, attempts)))
+		} else {
+			update.ClearNextAttemptAt()
+		}
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("saving webhook event %d: %v", ev.ID, err)
+	}
+
+	return dispatchErr
+}
+
+func webhookRetryBackoff(cfg Config, attempts int) time.Duration {
+	backoff := time.Duration(cfg.WebhookRetryIntervalSecs) * time.Second
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxWebhookRetryBackoff {
+			return maxWebhookRetryBackoff
+		}
+	}
+	return backoff
+}
+
+// retryWebhookEvents periodically processes the failed events which are due for a retry until ctx is done.
+func retryWebhookEvents(ctx context.Context, appCtx Context) {
+	interval := time.Duration(appCtx.cfg.WebhookRetryIntervalSecs) * time.Second
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			events, err := dueWebhookEvents(ctx, appCtx.db, time.Now())
+			if err != nil {
+				log.Printf("webhook retry: querying events: %v\n", err)
+				continue
+			}
+			for _, ev := range events {
+				if err := processWebhookEvent(ctx, appCtx, ev); err != nil {
+					log.Printf("webhook retry: %s event %s attempt %d: %v\n", ev.Source, ev.EventID, ev.Attempts+1, err)
+				}
+			}
+		}
+	}
+}
+
+// dueWebhookEvents returns the unprocessed events whose next attempt is due. Events stored without a next attempt
+// which were never attempted are due once the grace period has passed.
+func dueWebhookEvents(ctx context.Context, db *models.Client, now time.Time) ([]*models.WebhookEvent, error) {
+	return db.WebhookEvent.Query().
+		Where(
+			webhookevent.ProcessedAtIsNil(),
+			webhookevent.Or(
+				webhookevent.NextAttemptAtLTE(now),
+				webhookevent.And(
+					webhookevent.NextAttemptAtIsNil(),
+					webhookevent.Attempts(0),
+					webhookevent.ReceivedAtLTE(now.Add(-webhookProcessingGrace)),
+				),
+			),
+		).
+		Order(models.Asc(webhookevent.FieldNextAttemptAt)).
+		Limit(100).
+		All(ctx)
+}
+
+// ReplayWebhookEvent processes a stored webhook event again, irrespective of whether it was processed before.
+func ReplayWebhookEvent(ctx context.Context, cfg Config, id int) error {
+	db, err := models.Open(cfg.Driver, cfg.DataSource)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	appCtx := Context{
+		db:       db,
+		cfg:      cfg,
+		prices:   newPriceCache(time.Duration(cfg.PlanCacheTTLSecs) * time.Second),
+		webhooks: newWebhookSources(cfg),
+	}
+
+	ev, err := db.WebhookEvent.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return processWebhookEvent(ctx, appCtx, ev)
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+)
+
+func TestDueWebhookEvents(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+
+	// stored, but the app stopped before processing it
+	stored, _, err := storeWebhookEvent(ctx, appCtx.db, "stripe", "evt_stored", "invoice.paid",
+		[]byte(`{"id": "evt_stored", "type": "invoice.paid", "data": {"object": {}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stored before next_attempt_at was set on insert
+	legacy, err := appCtx.db.WebhookEvent.Create().
+		SetSource("stripe").
+		SetEventID("evt_legacy").
+		SetType("invoice.paid").
+		SetPayload([]byte(`{}`)).
+		SetReceivedAt(time.Now().Add(-time.Hour)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// failed on its last attempt
+	_, err = appCtx.db.WebhookEvent.Create().
+		SetSource("stripe").
+		SetEventID("evt_exhausted").
+		SetType("invoice.paid").
+		SetPayload([]byte(`{}`)).
+		SetAttempts(5).
+		SetReceivedAt(time.Now().Add(-time.Hour)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	due, err := dueWebhookEvents(ctx, appCtx.db, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].ID != legacy.ID {
+		t.Fatalf("got %d due events, want only the legacy event", len(due))
+	}
+
+	due, err = dueWebhookEvents(ctx, appCtx.db, time.Now().Add(webhookProcessingGrace+time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[int]bool{}
+	for _, ev := range due {
+		ids[ev.ID] = true
+	}
+	if len(due) != 2 || !ids[stored.ID] || !ids[legacy.ID] {
+		t.Fatalf("got %d due events, want the stored and the legacy event", len(due))
+	}
+
+	// a processed event is never due
+	appCtx.webhooks["stripe"] = &stripeWebhookSource{secret: testStripeWebhookSecret}
+	if err := processWebhookEvent(ctx, appCtx, stored); err != nil {
+		t.Fatal(err)
+	}
+	due, err = dueWebhookEvents(ctx, appCtx.db, time.Now().Add(webhookProcessingGrace+time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].ID != legacy.ID {
+		t.Fatalf("got %d due events after processing, want only the legacy event", len(due))
+	}
+}
+
+// countingWebhookSource counts the dispatched events.
+type countingWebhookSource struct {
+	WebhookSource
+	dispatched int
+}
+
+func (s *countingWebhookSource) Dispatch(ctx context.Context, appCtx Context, ev *models.WebhookEvent) error {
+	s.dispatched++
+	return nil
+}
+
+func TestProcessWebhookEventOnce(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+	source := &countingWebhookSource{}
+	appCtx.webhooks["counting"] = source
+
+	ev, _, err := storeWebhookEvent(ctx, appCtx.db, "counting", "evt_1", "ping", []byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the request and a retry worker which read the event before it was processed
+	for i := 0; i < 2; i++ {
+		if err := processWebhookEvent(ctx, appCtx, ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if source.dispatched != 1 {
+		t.Fatalf("got %d dispatches, want 1", source.dispatched)
+	}
+
+	// a claim left by a stopped app expires after the lease
+	claimed, err := appCtx.db.WebhookEvent.Create().
+		SetSource("counting").
+		SetEventID("evt_2").
+		SetType("ping").
+		SetPayload([]byte(`{}`)).
+		SetClaimedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := processWebhookEvent(ctx, appCtx, claimed); err != nil || source.dispatched != 1 {
+		t.Fatalf("got %d dispatches, want the claimed event to be skipped: %v", source.dispatched, err)
+	}
+	claimed, err = claimed.Update().SetClaimedAt(time.Now().Add(-2 * webhookClaimLease)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := processWebhookEvent(ctx, appCtx, claimed); err != nil || source.dispatched != 2 {
+		t.Fatalf("got %d dispatches, want the expired claim to be taken over: %v", source.dispatched, err)
+	}
+}
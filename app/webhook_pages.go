@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+	rl "github.com/adnaan/renderlayout"
+	"github.com/go-chi/chi"
+	"github.com/lithammer/shortuuid/v3"
+)
+
+type webhookDeliveryLog struct {
+	*models.WebhookDelivery
+	URL string
+}
+
+// webhookEndpoints returns the webhook endpoints of the owner and the log of their most recent deliveries.
+func webhookEndpoints(ctx context.Context, appCtx Context, owner string) ([]*models.WebhookEndpoint, []webhookDeliveryLog, error) {
+	endpoints, err := appCtx.db.WebhookEndpoint.Query().
+		Where(webhookendpoint.Owner(owner)).
+		Order(models.Asc(webhookendpoint.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, nil, nil
+	}
+
+	urls := make(map[string]string)
+	var endpointIDs []string
+	for _, endpoint := range endpoints {
+		urls[endpoint.ID] = endpoint.URL
+		endpointIDs = append(endpointIDs, endpoint.ID)
+	}
+
+	deliveries, err := appCtx.db.WebhookDelivery.Query().
+		Where(webhookdelivery.EndpointIDIn(endpointIDs...)).
+		Order(models.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(20).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deliveryLogs []webhookDeliveryLog
+	for _, delivery := range deliveries {
+		deliveryLogs = append(deliveryLogs, webhookDeliveryLog{
+			WebhookDelivery: delivery,
+			URL:             urls[delivery.EndpointID],
+		})
+	}
+
+	return endpoints, deliveryLogs, nil
+}
+
+func createWebhookEndpoint(appCtx Context) rl.Data {
+	type req struct {
+		URL string
+	}
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		if err := checkPermission(r, appCtx, permWebhooksManage); err != nil {
+			return nil, err
+		}
+
+		form := new(req)
+		err := r.ParseForm()
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		err = appCtx.formDecoder.Decode(form, r.Form)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		u, err := url.Parse(form.URL)
+		v := new(validator)
+		v.required("url", form.URL)
+		v.check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", "url", "must be a valid http(s) url")
+		if err := v.err(); err != nil {
+			return formErrors(err)
+		}
+		if err := checkWebhookURL(r.Context(), u, appCtx.cfg.WebhookAllowPrivateURLs); err != nil {
+			if errors.Is(err, errNonPublicAddress) {
+				return formErrors(fieldErrors{"url": "must resolve to a public address"})
+			}
+			return formErrors(fieldErrors{"url": "host can't be resolved"})
+		}
+
+		workspaceID := workspaceIDFromContext(r)
+		endpoint, err := appCtx.db.WebhookEndpoint.Create().
+			SetID(shortuuid.New()).
+			SetOwner(workspaceID).
+			SetURL(u.String()).
+			SetSecret("whsec_" + shortuuid.New()).
+			Save(r.Context())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		// like the api token, the secret is only shown in the response to its creation
+		return rl.D{
+			"can_manage_webhooks": true,
+			"webhook_secret":      endpoint.Secret,
+			"webhook_secret_url":  endpoint.URL,
+		}, nil
+	}
+}
+
+func deleteWebhookEndpoint(appCtx Context) rl.Data {
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		if err := checkPermission(r, appCtx, permWebhooksManage); err != nil {
+			return nil, err
+		}
+
+		id := chi.URLParam(r, "id")
+		workspaceID := workspaceIDFromContext(r)
+
+		deleted, err := appCtx.db.WebhookEndpoint.Delete().Where(
+			webhookendpoint.Owner(workspaceID), webhookendpoint.ID(id),
+		).Exec(r.Context())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		if deleted > 0 {
+			_, err = appCtx.db.WebhookDelivery.Delete().Where(webhookdelivery.EndpointID(id)).Exec(r.Context())
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+		}
+
+		http.Redirect(w, r, "/account", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
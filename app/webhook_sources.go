@@ -0,0 +1,220 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/webhook"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/subscription"
+)
+
+// webhook source types which can be configured in the webhook sources file
+const (
+	hmacSourceType   = "hmac"
+	githubSourceType = "github"
+)
+
+// WebhookSource receives events posted to /webhook/{source}.
+type WebhookSource interface {
+	// Verify checks the signature of the request payload.
+	Verify(r *http.Request, payload []byte) error
+	// Parse returns the id and the type of the event.
+	Parse(r *http.Request, payload []byte) (eventID string, eventType string, err error)
+	// Dispatch processes a stored event.
+	Dispatch(ctx context.Context, appCtx Context, ev *models.WebhookEvent) error
+}
+
+// accountWebhookSource is implemented by the sources whose events concern an account of the app, the received events
+// are audited for the account.
+type accountWebhookSource interface {
+	// AccountID returns the id of the account concerned by a processed event, empty if it's unknown.
+	AccountID(ctx context.Context, appCtx Context, ev *models.WebhookEvent) (string, error)
+}
+
+// newWebhookSources builds the registry of webhook sources keyed by the {source} url parameter.
+func newWebhookSources(cfg Config) map[string]WebhookSource {
+	sources := map[string]WebhookSource{
+		"stripe": &stripeWebhookSource{secret: cfg.StripeWebhookSecret},
+	}
+
+	for _, sc := range cfg.WebhookSources {
+		switch sc.Type {
+		case hmacSourceType:
+			sources[sc.Name] = newHMACWebhookSource(sc)
+		case githubSourceType:
+			sources[sc.Name] = newGithubWebhookSource(sc)
+		default:
+			log.Printf("webhook source %s has unknown type %s, skipping\n", sc.Name, sc.Type)
+		}
+	}
+
+	return sources
+}
+
+type stripeWebhookSource struct {
+	secret string
+}
+
+func (s *stripeWebhookSource) Verify(r *http.Request, payload []byte) error {
+	return webhook.ValidatePayload(payload, r.Header.Get("Stripe-Signature"), s.secret)
+}
+
+func (s *stripeWebhookSource) Parse(r *http.Request, payload []byte) (string, string, error) {
+	var event stripe.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return "", "", fmt.Errorf("parsing stripe event: %w", err)
+	}
+	return event.ID, event.Type, nil
+}
+
+func (s *stripeWebhookSource) Dispatch(ctx context.Context, appCtx Context, ev *models.WebhookEvent) error {
+	var event stripe.Event
+	if err := json.Unmarshal(ev.Payload, &event); err != nil {
+		return fmt.Errorf("parsing stripe event: %w", err)
+	}
+	return handleStripeEvent(ctx, appCtx, event)
+}
+
+// AccountID resolves the account from the subscriptions of the stripe customer of the event.
+func (s *stripeWebhookSource) AccountID(ctx context.Context, appCtx Context, ev *models.WebhookEvent) (string, error) {
+	var event stripe.Event
+	if err := json.Unmarshal(ev.Payload, &event); err != nil {
+		return "", fmt.Errorf("parsing stripe event: %w", err)
+	}
+	if event.Data == nil {
+		return "", nil
+	}
+	billingID := stripeCustomerID(event.Data.Object)
+	if billingID == "" {
+		return "", nil
+	}
+	sub, err := appCtx.db.Subscription.Query().
+		Where(subscription.BillingID(billingID)).
+		Order(models.Desc(subscription.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil {
+		if models.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return sub.Owner, nil
+}
+
+// stripeCustomerID returns the id of the customer of a stripe event object, the customer is either expanded or an id.
+func stripeCustomerID(object map[string]interface{}) string {
+	if object["object"] == "customer" {
+		id, _ := object["id"].(string)
+		return id
+	}
+	switch customer := object["customer"].(type) {
+	case string:
+		return customer
+	case map[string]interface{}:
+		id, _ := customer["id"].(string)
+		return id
+	}
+	return ""
+}
+
+// hmacWebhookSource verifies a hex encoded HMAC-SHA256 of the payload sent in a header.
+// The event id and type are read from headers if configured, otherwise from the `id` and `type` fields of the payload.
+type hmacWebhookSource struct {
+	name            string
+	secret          string
+	signatureHeader string
+	signaturePrefix string
+	eventIDHeader   string
+	eventTypeHeader string
+}
+
+func newHMACWebhookSource(sc WebhookSourceConfig) *hmacWebhookSource {
+	s := &hmacWebhookSource{
+		name:            sc.Name,
+		secret:          sc.Secret,
+		signatureHeader: sc.SignatureHeader,
+		eventIDHeader:   sc.EventIDHeader,
+		eventTypeHeader: sc.EventTypeHeader,
+	}
+	if s.signatureHeader == "" {
+		s.signatureHeader = "X-Signature-256"
+	}
+	return s
+}
+
+// newGithubWebhookSource returns a hmac source with github's header conventions.
+func newGithubWebhookSource(sc WebhookSourceConfig) *hmacWebhookSource {
+	return &hmacWebhookSource{
+		name:            sc.Name,
+		secret:          sc.Secret,
+		signatureHeader: "X-Hub-Signature-256",
+		signaturePrefix: "sha256=",
+		eventIDHeader:   "X-GitHub-Delivery",
+		eventTypeHeader: "X-GitHub-Event",
+	}
+}
+
+func (s *hmacWebhookSource) Verify(r *http.Request, payload []byte) error {
+	if s.secret == "" {
+		return fmt.Errorf("webhook source %s has no secret", s.name)
+	}
+	signature := strings.TrimPrefix(r.Header.Get(s.signatureHeader), s.signaturePrefix)
+	if signature == "" {
+		return fmt.Errorf("missing %s header", s.signatureHeader)
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid %s header: %v", s.signatureHeader, err)
+	}
+	if !hmac.Equal(got, signPayload(s.secret, payload)) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+func (s *hmacWebhookSource) Parse(r *http.Request, payload []byte) (string, string, error) {
+	var body struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+	if s.eventIDHeader == "" || s.eventTypeHeader == "" {
+		if err := json.Unmarshal(payload, &body); err != nil {
+			return "", "", fmt.Errorf("parsing %s event: %w", s.name, err)
+		}
+	}
+
+	eventID, eventType := body.ID, body.Type
+	if s.eventIDHeader != "" {
+		eventID = r.Header.Get(s.eventIDHeader)
+	}
+	if s.eventTypeHeader != "" {
+		eventType = r.Header.Get(s.eventTypeHeader)
+	}
+	if eventID == "" {
+		return "", "", fmt.Errorf("%s event has no id", s.name)
+	}
+
+	return eventID, eventType, nil
+}
+
+func (s *hmacWebhookSource) Dispatch(ctx context.Context, appCtx Context, ev *models.WebhookEvent) error {
+	log.Printf("webhook: received %s event %s of type %s\n", ev.Source, ev.EventID, ev.Type)
+	return nil
+}
+
+// signPayload returns the HMAC-SHA256 of the payload.
+func signPayload(secret string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
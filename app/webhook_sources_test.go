@@ -0,0 +1,140 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookevent"
+)
+
+func TestWebhookSources(t *testing.T) {
+	appCtx := newTestContext(t)
+	appCtx.webhooks = newWebhookSources(Config{WebhookSources: []WebhookSourceConfig{
+		{Name: "generic", Type: hmacSourceType, Secret: "generic-secret"},
+		{Name: "custom", Type: hmacSourceType, Secret: "custom-secret", SignatureHeader: "X-Custom-Signature",
+			EventIDHeader: "X-Custom-Id", EventTypeHeader: "X-Custom-Event"},
+		{Name: "github", Type: githubSourceType, Secret: "github-secret"},
+	}})
+	router := newTestWebhookRouter(appCtx)
+
+	sign := func(secret string, payload []byte) string {
+		return hex.EncodeToString(signPayload(secret, payload))
+	}
+	payload := []byte(`{"id":"evt_1","type":"thing.created"}`)
+
+	tests := []struct {
+		name      string
+		source    string
+		headers   map[string]string
+		want      int
+		eventID   string
+		eventType string
+	}{
+		{
+			name:    "generic hmac",
+			source:  "generic",
+			headers: map[string]string{"X-Signature-256": sign("generic-secret", payload)},
+			want:    http.StatusOK, eventID: "evt_1", eventType: "thing.created",
+		},
+		{
+			name:   "hmac with configured headers",
+			source: "custom",
+			headers: map[string]string{
+				"X-Custom-Signature": sign("custom-secret", payload),
+				"X-Custom-Id":        "custom-1",
+				"X-Custom-Event":     "custom.event",
+			},
+			want: http.StatusOK, eventID: "custom-1", eventType: "custom.event",
+		},
+		{
+			name:   "github",
+			source: "github",
+			headers: map[string]string{
+				"X-Hub-Signature-256": "sha256=" + sign("github-secret", payload),
+				"X-GitHub-Delivery":   "delivery-1",
+				"X-GitHub-Event":      "push",
+			},
+			want: http.StatusOK, eventID: "delivery-1", eventType: "push",
+		},
+		{
+			name:    "hmac signed with another secret",
+			source:  "generic",
+			headers: map[string]string{"X-Signature-256": sign("other-secret", payload)},
+			want:    http.StatusBadRequest,
+		},
+		{
+			name:    "hmac without signature",
+			source:  "generic",
+			headers: map[string]string{},
+			want:    http.StatusBadRequest,
+		},
+		{
+			name:    "hmac with a malformed signature",
+			source:  "generic",
+			headers: map[string]string{"X-Signature-256": "not-hex"},
+			want:    http.StatusBadRequest,
+		},
+		{
+			name:   "github signed with another secret",
+			source: "github",
+			headers: map[string]string{
+				"X-Hub-Signature-256": "sha256=" + sign("other-secret", payload),
+				"X-GitHub-Delivery":   "delivery-2",
+				"X-GitHub-Event":      "push",
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name:   "github with the generic header",
+			source: "github",
+			headers: map[string]string{
+				"X-Signature-256":   sign("github-secret", payload),
+				"X-GitHub-Delivery": "delivery-3",
+				"X-GitHub-Event":    "push",
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name:    "unknown source",
+			source:  "gitlab",
+			headers: map[string]string{"X-Signature-256": sign("generic-secret", payload)},
+			want:    http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook/"+tt.source, bytes.NewReader(payload))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+
+			if tt.eventID == "" {
+				return
+			}
+			ev, err := appCtx.db.WebhookEvent.Query().
+				Where(webhookevent.Source(tt.source), webhookevent.EventID(tt.eventID)).
+				Only(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ev.Type != tt.eventType {
+				t.Fatalf("got event type %q, want %q", ev.Type, tt.eventType)
+			}
+		})
+	}
+
+	// the rejected requests aren't stored
+	if n := appCtx.db.WebhookEvent.Query().CountX(context.Background()); n != 3 {
+		t.Fatalf("got %d stored events, want 3", n)
+	}
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// errNonPublicAddress is returned for webhook urls which resolve to a loopback, private, link local or otherwise non
+// public address, so the endpoints can't be used to reach the internal network of the app.
+var errNonPublicAddress = errors.New("webhook urls must resolve to a public address")
+
+// nonPublicNetworks are the special purpose ranges of the IANA registries which webhooks aren't delivered to.
+var nonPublicNetworks = func() []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.0.0.0/24",
+		"192.0.2.0/24",
+		"192.168.0.0/16",
+		"198.18.0.0/15",
+		"198.51.100.0/24",
+		"203.0.113.0/24",
+		"224.0.0.0/4",
+		"240.0.0.0/4",
+		"::/128",
+		"::1/128",
+		"64:ff9b::/96",
+		"100::/64",
+		"2001:db8::/32",
+		"fc00::/7",
+		"fe80::/10",
+		"ff00::/8",
+	} {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+func isPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWebhookURL verifies that the host of the url only resolves to public addresses, errNonPublicAddress is returned
+// if it doesn't.
+func checkWebhookURL(ctx context.Context, u *url.URL, allowPrivate bool) error {
+	if allowPrivate {
+		return nil
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublicIP(ip) {
+			return errNonPublicAddress
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("the host %s can't be resolved", host)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return errNonPublicAddress
+		}
+	}
+	return nil
+}
+
+// newWebhookClient returns the client which delivers the webhooks. Unless allowPrivate is set, the address is checked
+// again on every dial, so a url whose host resolves to the internal network after it was registered or which
+// redirects there isn't delivered to. Proxies from the environment aren't used as they would skip the check.
+func newWebhookClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%w: %s", errNonPublicAddress, host)
+			}
+			return nil
+		}
+	}
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+	}
+	for _, tt := range tests {
+		if got := isPublicIP(net.ParseIP(tt.ip)); got != tt.public {
+			t.Errorf("isPublicIP(%s) = %v, want %v", tt.ip, got, tt.public)
+		}
+	}
+}
+
+func TestCheckWebhookURL(t *testing.T) {
+	for _, rawURL := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"https://[::1]/hook",
+		"http://10.0.0.5/hook",
+	} {
+		u, _ := url.Parse(rawURL)
+		if err := checkWebhookURL(context.Background(), u, false); !errors.Is(err, errNonPublicAddress) {
+			t.Errorf("%s: got %v, want %v", rawURL, err, errNonPublicAddress)
+		}
+		if err := checkWebhookURL(context.Background(), u, true); err != nil {
+			t.Errorf("%s: got %v with private urls allowed", rawURL, err)
+		}
+	}
+}
+
+func TestWebhookClientRefusesPrivateAddresses(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the receiver on a private address was called")
+	}))
+	defer receiver.Close()
+
+	_, err := newWebhookClient(false).Post(receiver.URL, "application/json", strings.NewReader("{}"))
+	if !errors.Is(err, errNonPublicAddress) {
+		t.Fatalf("got %v, want %v", err, errNonPublicAddress)
+	}
+
+}
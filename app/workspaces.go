@@ -0,0 +1,409 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/adnaan/authn"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/lithammer/shortuuid/v3"
+
+	rl "github.com/adnaan/renderlayout"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models"
+	"github.com/adnaan/gomodest-starter/app/gen/models/group"
+	"github.com/adnaan/gomodest-starter/app/gen/models/invitation"
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+	"github.com/adnaan/gomodest-starter/app/gen/models/tag"
+	"github.com/adnaan/gomodest-starter/app/gen/models/task"
+	"github.com/adnaan/gomodest-starter/app/gen/models/usage"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookdelivery"
+	"github.com/adnaan/gomodest-starter/app/gen/models/webhookendpoint"
+	"github.com/adnaan/gomodest-starter/app/gen/models/workspace"
+)
+
+const (
+	// the workspace selected on the account page is stored in the authn session
+	workspaceSessionKey = "workspace_id"
+	// api clients select a workspace with this header, the personal workspace is used without it
+	workspaceHeader        = "X-Workspace-ID"
+	personalWorkspaceName  = "Personal"
+	maxWorkspaceNameLength = 100
+)
+
+type contextKey string
+
+const workspaceIDKey contextKey = "key_workspace_id"
+
+// workspaceView is an item of the workspace switcher.
+type workspaceView struct {
+	ID      string
+	Name    string
+	Role    membership.Role
+	Current bool
+}
+
+// memberView is a member listed on the members tab.
+type memberView struct {
+	Email string          `json:"email"`
+	Role  membership.Role `json:"role"`
+}
+
+// withWorkspace stores the id of the current workspace of the authenticated account in the request context. A
+// workspace selected with the X-Workspace-ID header which the account isn't a member of is forbidden.
+func withWorkspace(appCtx Context) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			workspaceID, err := currentWorkspaceID(r, appCtx, authn.AccountIDFromContext(r))
+			if err != nil {
+				render.Render(w, r, ErrInternal(err))
+				return
+			}
+			if requested := r.Header.Get(workspaceHeader); requested != "" && requested != workspaceID {
+				render.Render(w, r, ErrForbidden(fmt.Errorf("not a member of the workspace %s", requested)))
+				return
+			}
+			ctx := context.WithValue(r.Context(), workspaceIDKey, workspaceID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// workspaceIDFromContext returns the workspace stored by withWorkspace.
+func workspaceIDFromContext(r *http.Request) string {
+	workspaceID, _ := r.Context().Value(workspaceIDKey).(string)
+	return workspaceID
+}
+
+// currentWorkspaceID returns the workspace selected by the X-Workspace-ID header or else the session. The personal
+// workspace of the account is returned if none is selected or the account isn't a member of the selected one.
+func currentWorkspaceID(r *http.Request, appCtx Context, accountID string) (string, error) {
+	selected := r.Header.Get(workspaceHeader)
+	if selected == "" {
+		// requests authenticated with an api token have no session
+		if account, err := appCtx.authn.CurrentAccount(r); err == nil {
+			if val, err := account.Attributes().Session().Get(workspaceSessionKey); err == nil {
+				selected, _ = val.(string)
+			}
+		}
+	}
+
+	if selected != "" && selected != accountID {
+		isMember, err := isWorkspaceMember(r.Context(), appCtx, accountID, selected)
+		if err != nil {
+			return "", err
+		}
+		if isMember {
+			return selected, nil
+		}
+	}
+
+	personal, err := ensurePersonalWorkspace(r.Context(), appCtx, accountID)
+	if err != nil {
+		return "", err
+	}
+	return personal.ID, nil
+}
+
+func isWorkspaceMember(ctx context.Context, appCtx Context, accountID, workspaceID string) (bool, error) {
+	return appCtx.db.Membership.Query().Where(
+		membership.AccountID(accountID), membership.HasWorkspaceWith(workspace.ID(workspaceID)),
+	).Exist(ctx)
+}
+
+// ensurePersonalWorkspace returns the personal workspace of the account and creates it on first use. It has the id
+// of the account, so the tasks owned by the account before workspaces existed belong to it.
+func ensurePersonalWorkspace(ctx context.Context, appCtx Context, accountID string) (*models.Workspace, error) {
+	personal, err := appCtx.db.Workspace.Get(ctx, accountID)
+	if err == nil {
+		return personal, nil
+	}
+	if !models.IsNotFound(err) {
+		return nil, err
+	}
+
+	personal, err = createWorkspace(ctx, appCtx, accountID, accountID, personalWorkspaceName)
+	if models.IsConstraintError(err) {
+		// created by a concurrent request
+		return appCtx.db.Workspace.Get(ctx, accountID)
+	}
+	return personal, err
+}
+
+// createWorkspace creates a workspace with the account as its owner.
+func createWorkspace(ctx context.Context, appCtx Context, id, accountID, name string) (*models.Workspace, error) {
+	email, err := accountEmail(ctx, appCtx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := appCtx.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := tx.Workspace.Create().
+		SetID(id).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.Membership.Create().
+		SetID(shortuuid.New()).
+		SetAccountID(accountID).
+		SetEmail(email).
+		SetRole(membership.RoleOwner).
+		SetWorkspace(created).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return created, tx.Commit()
+}
+
+func accountEmail(ctx context.Context, appCtx Context, accountID string) (string, error) {
+	id, err := uuid.Parse(accountID)
+	if err != nil {
+		return "", err
+	}
+	acc, err := appCtx.accounts.Account.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return acc.Email, nil
+}
+
+// workspaceOwner returns the id and the billing id of the account which created the workspace, the billing id is empty
+// if the owner never subscribed.
+func workspaceOwner(ctx context.Context, appCtx Context, workspaceID string) (string, string, error) {
+	owner, err := appCtx.db.Membership.Query().
+		Where(membership.RoleEQ(membership.RoleOwner), membership.HasWorkspaceWith(workspace.ID(workspaceID))).
+		Order(models.Asc(membership.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	id, err := uuid.Parse(owner.AccountID)
+	if err != nil {
+		return "", "", err
+	}
+	acc, err := appCtx.accounts.Account.Get(ctx, id)
+	if err != nil {
+		return owner.AccountID, "", err
+	}
+	billingID, _ := acc.Attributes[billingIDKey].(string)
+	return owner.AccountID, billingID, nil
+}
+
+// ownedWorkspaces returns the workspaces which the account is the only owner of, split into the ones without other
+// members and the shared ones whose members would be left without an owner.
+func ownedWorkspaces(ctx context.Context, appCtx Context, accountID string) ([]*models.Workspace, []*models.Workspace, error) {
+	workspaces, err := appCtx.db.Workspace.Query().
+		Where(workspace.HasMembershipsWith(membership.AccountID(accountID), membership.RoleEQ(membership.RoleOwner))).
+		WithMemberships().
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var unshared, shared []*models.Workspace
+	for _, ws := range workspaces {
+		otherMembers, otherOwners := 0, 0
+		for _, m := range ws.Edges.Memberships {
+			if m.AccountID == accountID {
+				continue
+			}
+			otherMembers++
+			if m.Role == membership.RoleOwner {
+				otherOwners++
+			}
+		}
+		switch {
+		case otherOwners > 0:
+		case otherMembers > 0:
+			shared = append(shared, ws)
+		default:
+			unshared = append(unshared, ws)
+		}
+	}
+	return unshared, shared, nil
+}
+
+// deleteWorkspace deletes the workspace along with its tasks, tags, groups, webhooks, invitations, memberships and
+// usage. It's expected to run in a transaction, see withTx.
+func deleteWorkspace(ctx context.Context, appCtx Context, workspaceID string) error {
+	db := appCtx.db
+	if _, err := db.Task.Delete().Where(task.Owner(workspaceID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.Tag.Delete().Where(tag.Owner(workspaceID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.Group.Delete().Where(group.HasWorkspaceWith(workspace.ID(workspaceID))).Exec(ctx); err != nil {
+		return err
+	}
+	endpointIDs, err := db.WebhookEndpoint.Query().Where(webhookendpoint.Owner(workspaceID)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := db.WebhookDelivery.Delete().Where(webhookdelivery.EndpointIDIn(endpointIDs...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.WebhookEndpoint.Delete().Where(webhookendpoint.Owner(workspaceID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.Invitation.Delete().Where(invitation.HasWorkspaceWith(workspace.ID(workspaceID))).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.Membership.Delete().Where(membership.HasWorkspaceWith(workspace.ID(workspaceID))).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.Usage.Delete().Where(usage.Owner(workspaceID)).Exec(ctx); err != nil {
+		return err
+	}
+	return db.Workspace.DeleteOneID(workspaceID).Exec(ctx)
+}
+
+// accountWorkspaces returns the workspaces of the account for the workspace switcher.
+func accountWorkspaces(ctx context.Context, appCtx Context, accountID, currentWorkspaceID string) ([]workspaceView, error) {
+	memberships, err := appCtx.db.Membership.Query().
+		Where(membership.AccountID(accountID)).
+		WithWorkspace().
+		Order(models.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaces []workspaceView
+	for _, m := range memberships {
+		workspaces = append(workspaces, workspaceView{
+			ID:      m.Edges.Workspace.ID,
+			Name:    m.Edges.Workspace.Name,
+			Role:    m.Role,
+			Current: m.Edges.Workspace.ID == currentWorkspaceID,
+		})
+	}
+	return workspaces, nil
+}
+
+// workspaceMembers returns the json props of the members, groups and guests components, including the pending
+// invitations if the account can manage them.
+func workspaceMembers(ctx context.Context, appCtx Context, workspaceID, accountID string) (string, error) {
+	memberships, err := appCtx.db.Membership.Query().
+		Where(membership.HasWorkspaceWith(workspace.ID(workspaceID))).
+		Order(models.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	members := make([]memberView, 0, len(memberships))
+	for _, m := range memberships {
+		members = append(members, memberView{Email: m.Email, Role: m.Role})
+	}
+
+	canInvite, err := hasPermission(ctx, appCtx, accountID, workspaceID, permMembersManage)
+	if err != nil {
+		return "", err
+	}
+	invitations := []invitationView{}
+	if canInvite {
+		invitations, err = pendingInvitations(ctx, appCtx, workspaceID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	canManageGroups, err := hasPermission(ctx, appCtx, accountID, workspaceID, permGroupsManage)
+	if err != nil {
+		return "", err
+	}
+
+	props, err := json.Marshal(map[string]interface{}{
+		"members":           members,
+		"invitations":       invitations,
+		"can_invite":        canInvite,
+		"can_manage_groups": canManageGroups,
+		"permissions":       allPermissions,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(props), nil
+}
+
+func createWorkspacePage(appCtx Context) rl.Data {
+	type req struct {
+		Name string
+	}
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		form := new(req)
+		err := r.ParseForm()
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		err = appCtx.formDecoder.Decode(form, r.Form)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		v := new(validator)
+		v.required("name", form.Name)
+		v.maxLength("name", form.Name, maxWorkspaceNameLength)
+		if err := v.err(); err != nil {
+			return formErrors(err)
+		}
+
+		account, err := appCtx.authn.CurrentAccount(r)
+		if err != nil {
+			return nil, err
+		}
+		created, err := createWorkspace(r.Context(), appCtx, shortuuid.New(), account.ID().String(), form.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		err = account.Attributes().Session().Set(w, workspaceSessionKey, created.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		http.Redirect(w, r, "/account", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
+
+// switchWorkspace selects the workspace for the session of the account.
+func switchWorkspace(appCtx Context) rl.Data {
+	return func(w http.ResponseWriter, r *http.Request) (rl.D, error) {
+		id := chi.URLParam(r, "id")
+		account, err := appCtx.authn.CurrentAccount(r)
+		if err != nil {
+			return nil, err
+		}
+
+		isMember, err := isWorkspaceMember(r.Context(), appCtx, account.ID().String(), id)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if !isMember {
+			return nil, fmt.Errorf("%w", fmt.Errorf("workspace not found"))
+		}
+
+		err = account.Attributes().Session().Set(w, workspaceSessionKey, id)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		http.Redirect(w, r, "/app", http.StatusSeeOther)
+		return rl.D{}, nil
+	}
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v3"
+
+	"github.com/adnaan/gomodest-starter/app/gen/models/membership"
+)
+
+func TestOwnedWorkspaces(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+	ownerID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	memberID, _ := newTestAccount(t, appCtx, "member@example.com")
+
+	if _, err := ensurePersonalWorkspace(ctx, appCtx, ownerID); err != nil {
+		t.Fatal(err)
+	}
+	addMember := func(workspaceID, accountID string, role membership.Role) {
+		_, err := appCtx.db.Membership.Create().
+			SetID(shortuuid.New()).
+			SetAccountID(accountID).
+			SetEmail(accountID + "@example.com").
+			SetRole(role).
+			SetWorkspaceID(workspaceID).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"shared", "co-owned"} {
+		if _, err := createWorkspace(ctx, appCtx, name, ownerID, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addMember("shared", memberID, membership.RoleAdmin)
+	addMember("co-owned", memberID, membership.RoleOwner)
+
+	unshared, shared, err := ownedWorkspaces(ctx, appCtx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unshared) != 1 || unshared[0].ID != ownerID {
+		t.Fatalf("got %d unshared workspaces, want the personal one", len(unshared))
+	}
+	if len(shared) != 1 || shared[0].ID != "shared" {
+		t.Fatalf("got %d shared workspaces, want the one without another owner", len(shared))
+	}
+}
+
+func TestDeleteWorkspace(t *testing.T) {
+	appCtx := newTestContext(t)
+	ctx := context.Background()
+	accountID, _ := newTestAccount(t, appCtx, "owner@example.com")
+	personal, err := ensurePersonalWorkspace(ctx, appCtx, accountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := appCtx.db.Group.Create().SetID(shortuuid.New()).SetName("team").SetWorkspace(personal).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newTask(ctx, appCtx, personal.ID, "tagged", taskFieldsRequest{Tags: []string{"work"}, GroupID: &g.ID}); err != nil {
+		t.Fatal(err)
+	}
+	endpoint, err := appCtx.db.WebhookEndpoint.Create().
+		SetID(shortuuid.New()).
+		SetOwner(personal.ID).
+		SetURL("https://example.com/hook").
+		SetSecret("whsec_test").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = appCtx.db.WebhookDelivery.Create().
+		SetEndpointID(endpoint.ID).
+		SetEventID("evt_1").
+		SetEventType(taskCreatedEvent).
+		SetPayload([]byte(`{}`)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the data of other workspaces is kept
+	if _, err := newTask(ctx, appCtx, "other", "kept", taskFieldsRequest{Tags: []string{"work"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	err = withTx(ctx, appCtx, func(txCtx Context) error {
+		return deleteWorkspace(ctx, txCtx, personal.ID)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, count := range map[string]func(context.Context) (int, error){
+		"tasks":      appCtx.db.Task.Query().Count,
+		"tags":       appCtx.db.Tag.Query().Count,
+		"groups":     appCtx.db.Group.Query().Count,
+		"endpoints":  appCtx.db.WebhookEndpoint.Query().Count,
+		"deliveries": appCtx.db.WebhookDelivery.Query().Count,
+		"members":    appCtx.db.Membership.Query().Count,
+		"workspaces": appCtx.db.Workspace.Query().Count,
+	} {
+		want := 0
+		if name == "tasks" || name == "tags" {
+			want = 1
+		}
+		got, err := count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %d %s, want %d", got, name, want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+import { Controller } from "stimulus"
+import { connectStreamSource, disconnectStreamSource } from "@hotwired/turbo"
+
+// applies the turbo stream fragments sent by the server to the page
+export default class extends Controller {
+    static values = { url: String }
+
+    connect() {
+        this.source = new EventSource(this.urlValue);
+        connectStreamSource(this.source);
+    }
+
+    disconnect() {
+        disconnectStreamSource(this.source);
+        this.source.close();
+    }
+}
@@ -5,6 +5,8 @@ export default class extends Controller {
 
     connect(){
         this.stripe = Stripe(this.stripeValue);
+        // repeated clicks reuse the checkout session created by the first one
+        this.idempotencyKey = Date.now().toString(36) + Math.random().toString(36).slice(2);
     }
 
     showErrorMessage(message) {
@@ -39,7 +41,8 @@ export default class extends Controller {
         return fetch("/account/checkout", {
             method: "POST",
             headers: {
-                "Content-Type": "application/json"
+                "Content-Type": "application/json",
+                "Idempotency-Key": self.idempotencyKey
             },
             body: JSON.stringify({
                 price: priceId
@@ -46,6 +46,7 @@ func main() {
 	vv := valve.New()
 	baseCtx := vv.Context()
 	configFile := flag.String("config", "", "path to config file")
+	replayWebhookEvent := flag.Int("replay-webhook-event", 0, "id of a stored webhook event to process again")
 	envPrefix := os.Getenv("ENV_PREFIX")
 	if envPrefix == "" {
 		envPrefix = "app"
@@ -57,6 +58,15 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if *replayWebhookEvent != 0 {
+		err = app.ReplayWebhookEvent(baseCtx, cfg, *replayWebhookEvent)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("webhook event %d replayed\n", *replayWebhookEvent)
+		return
+	}
+
 	r := app.Router(baseCtx, cfg)
 	workDir, _ := os.Getwd()
 	public := http.Dir(filepath.Join(workDir, "./", "public", "assets"))